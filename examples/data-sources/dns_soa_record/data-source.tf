@@ -0,0 +1,11 @@
+data "dns_soa_record" "example" {
+  zone              = "example.com"
+  query_nameservers = true
+}
+
+output "stale_nameservers" {
+  value = [
+    for nameserver, serial in data.dns_soa_record.example.nameserver_serials : nameserver
+    if serial != data.dns_soa_record.example.serial
+  ]
+}
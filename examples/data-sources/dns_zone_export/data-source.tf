@@ -0,0 +1,8 @@
+data "dns_zone_export" "example" {
+  zone = "example.com."
+}
+
+resource "local_file" "example" {
+  filename = "${path.module}/db.example.com.${data.dns_zone_export.example.serial}"
+  content  = data.dns_zone_export.example.content
+}
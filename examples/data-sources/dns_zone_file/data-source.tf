@@ -0,0 +1,16 @@
+data "dns_zone_file" "example" {
+  zone = "example.com."
+  path = "${path.module}/db.example.com"
+}
+
+resource "dns_a_record_set" "example" {
+  for_each = {
+    for key, record_set in data.dns_zone_file.example.record_sets : key => record_set
+    if record_set.type == "A"
+  }
+
+  zone      = "example.com."
+  name      = each.value.name
+  addresses = each.value.rdata
+  ttl       = each.value.ttl
+}
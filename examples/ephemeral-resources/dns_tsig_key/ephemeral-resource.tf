@@ -0,0 +1,18 @@
+ephemeral "dns_tsig_key" "updates" {
+  name      = "tsig-key."
+  algorithm = "hmac-sha256"
+}
+
+# Write the key to a secret store with a write-only argument, so the secret
+# is never stored in the plan or state.
+resource "vault_kv_secret_v2" "tsig_key" {
+  mount = "secret"
+  name  = "dns/tsig-key"
+
+  data_json_wo = jsonencode({
+    name      = ephemeral.dns_tsig_key.updates.name
+    algorithm = ephemeral.dns_tsig_key.updates.algorithm
+    secret    = ephemeral.dns_tsig_key.updates.secret
+  })
+  data_json_wo_version = 1
+}
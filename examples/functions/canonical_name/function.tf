@@ -0,0 +1,4 @@
+output "www" {
+  # Returns "www.example.com."
+  value = provider::dns::canonical_name("WWW.Example.com")
+}
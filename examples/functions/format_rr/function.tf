@@ -0,0 +1,12 @@
+output "mx" {
+  # Returns "example.com.\t300\tIN\tMX\t10 mail.example.com."
+  value = provider::dns::format_rr({
+    name = "example.com."
+    ttl  = 300
+    type = "MX"
+    rdata = {
+      preference = 10
+      mx         = "mail.example.com."
+    }
+  })
+}
@@ -0,0 +1,8 @@
+variable "hostname" {
+  type = string
+
+  validation {
+    condition     = provider::dns::is_subdomain(var.hostname, "example.com.")
+    error_message = "The hostname must be within example.com."
+  }
+}
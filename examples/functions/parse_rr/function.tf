@@ -0,0 +1,13 @@
+locals {
+  mx = provider::dns::parse_rr("example.com. 300 IN MX 10 mail.example.com.")
+}
+
+resource "dns_mx_record_set" "mx" {
+  zone = local.mx.name
+  ttl  = local.mx.ttl
+
+  mx {
+    preference = local.mx.rdata.preference
+    exchange   = local.mx.rdata.mx
+  }
+}
@@ -0,0 +1,5 @@
+resource "dns_ptr_record" "www" {
+  fqdn = provider::dns::reverse_name("192.168.1.10")
+  ptr  = "www.example.com."
+  ttl  = 300
+}
@@ -0,0 +1,11 @@
+locals {
+  # Returns { name = "www", zone = "example.com." }
+  www = provider::dns::split_fqdn("www.example.com.", "example.com.")
+}
+
+resource "dns_a_record_set" "www" {
+  zone      = local.www.zone
+  name      = local.www.name
+  addresses = ["192.168.0.1"]
+  ttl       = 300
+}
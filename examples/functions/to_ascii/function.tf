@@ -0,0 +1,4 @@
+output "bucher" {
+  # Returns "xn--bcher-kva.example.com."
+  value = provider::dns::to_ascii("bücher.example.com.")
+}
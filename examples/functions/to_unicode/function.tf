@@ -0,0 +1,4 @@
+output "bucher" {
+  # Returns "bücher.example.com."
+  value = provider::dns::to_unicode("xn--bcher-kva.example.com.")
+}
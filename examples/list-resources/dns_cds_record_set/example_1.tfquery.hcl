@@ -0,0 +1,7 @@
+list "dns_cds_record_set" "example" {
+  provider = dns
+
+  config {
+    zone = "example.com."
+  }
+}
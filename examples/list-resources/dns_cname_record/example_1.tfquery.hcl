@@ -0,0 +1,7 @@
+list "dns_cname_record" "example" {
+  provider = dns
+
+  config {
+    zone = "example.com."
+  }
+}
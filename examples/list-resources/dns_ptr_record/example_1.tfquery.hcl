@@ -0,0 +1,7 @@
+list "dns_ptr_record" "example" {
+  provider = dns
+
+  config {
+    zone = "1.168.192.in-addr.arpa."
+  }
+}
@@ -0,0 +1,2 @@
+# Import using the FQDN.
+terraform import dns_cds_record_set.delete example.com.
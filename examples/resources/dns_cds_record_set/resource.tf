@@ -0,0 +1,10 @@
+# Request the removal of the DS records of the zone from its parent
+resource "dns_cds_record_set" "delete" {
+  zone = "example.com."
+  cds {
+    key_tag     = 0
+    algorithm   = 0
+    digest_type = 0
+    digest      = "00"
+  }
+}
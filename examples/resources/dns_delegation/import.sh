@@ -0,0 +1,2 @@
+# Import using the FQDN of the delegated zone.
+terraform import dns_delegation.internal internal.example.com.
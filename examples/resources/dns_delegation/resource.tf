@@ -0,0 +1,15 @@
+resource "dns_delegation" "internal" {
+  zone  = "example.com."
+  child = "internal"
+
+  nameserver {
+    name      = "ns1.internal.example.com."
+    addresses = ["192.0.2.53", "2001:db8::53"]
+  }
+
+  nameserver {
+    name = "a.iana-servers.net."
+  }
+
+  ttl = 300
+}
@@ -0,0 +1,2 @@
+# Import using the FQDN.
+terraform import dns_dnskey_record_set.keys example.com.
@@ -0,0 +1,14 @@
+resource "dns_dnssec_key" "ksk" {
+  zone      = "example.com."
+  algorithm = "ECDSAP256SHA256"
+}
+
+resource "dns_dnskey_record_set" "keys" {
+  zone = "example.com."
+  dnskey {
+    flags      = dns_dnssec_key.ksk.flags
+    algorithm  = 13
+    public_key = dns_dnssec_key.ksk.public_key
+  }
+  ttl = 3600
+}
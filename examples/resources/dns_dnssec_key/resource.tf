@@ -0,0 +1,8 @@
+resource "dns_dnssec_key" "ksk" {
+  zone      = "example.com."
+  algorithm = "ECDSAP256SHA256"
+}
+
+output "ds" {
+  value = dns_dnssec_key.ksk.ds_sha256
+}
@@ -0,0 +1,2 @@
+# Import using the FQDN.
+terraform import dns_ds_record_set.child child.example.com.
@@ -0,0 +1,11 @@
+resource "dns_ds_record_set" "child" {
+  zone = "example.com."
+  name = "child"
+  ds {
+    key_tag     = 60485
+    algorithm   = 13
+    digest_type = 2
+    digest      = "e06d44b80b8f1d39a95c0b0d7c65d08458e880409bbc683457104237c7f8ec8d"
+  }
+  ttl = 3600
+}
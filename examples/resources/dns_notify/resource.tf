@@ -0,0 +1,14 @@
+resource "dns_a_record_set" "www" {
+  zone      = "example.com."
+  name      = "www"
+  addresses = ["192.0.2.1"]
+}
+
+resource "dns_notify" "example" {
+  zone    = "example.com."
+  servers = ["192.0.2.53", "[2001:db8::53]:5353"]
+
+  triggers = {
+    www = join(",", dns_a_record_set.www.addresses)
+  }
+}
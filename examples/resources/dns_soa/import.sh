@@ -0,0 +1,2 @@
+# Import using the zone.
+terraform import dns_soa.example example.com.
@@ -0,0 +1,8 @@
+resource "dns_soa" "example" {
+  zone            = "example.com."
+  refresh         = 7200
+  retry           = 900
+  expire          = 1209600
+  minimum         = 300
+  serial_strategy = "date"
+}
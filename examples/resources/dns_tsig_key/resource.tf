@@ -0,0 +1,9 @@
+resource "dns_tsig_key" "updates" {
+  name      = "tsig-key."
+  algorithm = "hmac-sha256"
+}
+
+resource "local_sensitive_file" "named_key" {
+  filename = "/etc/named/tsig-key.conf"
+  content  = dns_tsig_key.updates.bind_config
+}
@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package dnssec generates DNSSEC keys and derives the records published for
+// them, without any network access.
+package dnssec
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
+)
+
+// Flags of zone signing and key signing keys.
+const (
+	FlagsZSK uint16 = dns.ZONE
+	FlagsKSK uint16 = dns.ZONE | dns.SEP
+)
+
+// algorithms are the key algorithms keys can be generated for, by mnemonic.
+var algorithms = map[string]uint8{
+	"ECDSAP256SHA256": dns.ECDSAP256SHA256,
+	"ED25519":         dns.ED25519,
+	"RSASHA256":       dns.RSASHA256,
+}
+
+// Algorithms returns the mnemonics of the supported key algorithms.
+func Algorithms() []string {
+	names := make([]string, 0, len(algorithms))
+	for name := range algorithms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Algorithm returns the number of the key algorithm with the given mnemonic.
+func Algorithm(name string) (uint8, error) {
+	alg, ok := algorithms[name]
+	if !ok {
+		return 0, fmt.Errorf("unsupported key algorithm: %q", name)
+	}
+
+	return alg, nil
+}
+
+// DefaultBits returns the key size used for alg when none is given. The ECDSA
+// and Ed25519 algorithms have a fixed key size.
+func DefaultBits(alg uint8) int {
+	switch alg {
+	case dns.RSASHA256:
+		return 2048
+	default:
+		return 256
+	}
+}
+
+// Key is a generated key pair.
+type Key struct {
+	DNSKEY *dns.DNSKEY
+
+	// PrivateKey is the private key in the BIND private key file format.
+	PrivateKey string
+}
+
+// GenerateKey generates a key pair for zone.
+func GenerateKey(zone string, alg uint8, flags uint16, bits int) (*Key, error) {
+	if alg != dns.RSASHA256 && bits != DefaultBits(alg) {
+		return nil, fmt.Errorf("key size of %s keys must be %d, got %d", dns.AlgorithmToString[alg], DefaultBits(alg), bits)
+	}
+
+	key := &dns.DNSKEY{
+		Hdr:       rdata.Header(dns.Fqdn(zone), dns.TypeDNSKEY, 0),
+		Flags:     flags,
+		Protocol:  3,
+		Algorithm: alg,
+	}
+
+	priv, err := key.Generate(bits)
+	if err != nil {
+		return nil, fmt.Errorf("error generating %s key: %w", dns.AlgorithmToString[alg], err)
+	}
+
+	return &Key{
+		DNSKEY:     key,
+		PrivateKey: key.PrivateKeyString(priv),
+	}, nil
+}
+
+// DS returns the DS record of key with the given digest type.
+func DS(key *dns.DNSKEY, digestType uint8) (*dns.DS, error) {
+	ds := key.ToDS(digestType)
+	if ds == nil {
+		return nil, fmt.Errorf("error computing %s digest of key %d", dns.HashToString[digestType], key.KeyTag())
+	}
+
+	return ds, nil
+}
+
+// FileName returns the base name BIND uses for the files of key.
+func FileName(key *dns.DNSKEY) string {
+	return fmt.Sprintf("K%s+%03d+%05d", dns.CanonicalName(key.Hdr.Name), key.Algorithm, key.KeyTag())
+}
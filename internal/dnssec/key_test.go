@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnssec
+
+import (
+	"crypto"
+	"strings"
+	"testing"
+
+	"github.com/miekg/dns"
+)
+
+func TestGenerateKey(t *testing.T) {
+	testCases := map[string]struct {
+		flags uint16
+		bits  int
+	}{
+		"ECDSAP256SHA256": {
+			flags: FlagsKSK,
+			bits:  256,
+		},
+		"ED25519": {
+			flags: FlagsZSK,
+			bits:  256,
+		},
+		"RSASHA256": {
+			flags: FlagsKSK,
+			bits:  1024,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			alg, err := Algorithm(name)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			key, err := GenerateKey("Example.com", alg, testCase.flags, testCase.bits)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if key.DNSKEY.Hdr.Name != "Example.com." || key.DNSKEY.Flags != testCase.flags || key.DNSKEY.Algorithm != alg {
+				t.Errorf("unexpected DNSKEY record: %s", key.DNSKEY)
+			}
+
+			// The private key must match the public key in the DNSKEY record
+			priv, err := key.DNSKEY.NewPrivateKey(key.PrivateKey)
+			if err != nil {
+				t.Fatalf("error parsing private key: %s", err)
+			}
+
+			rrsig := &dns.RRSIG{
+				Hdr:        dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeRRSIG, Class: dns.ClassINET},
+				Inception:  0,
+				Expiration: 1<<32 - 1,
+				KeyTag:     key.DNSKEY.KeyTag(),
+				SignerName: "example.com.",
+				Algorithm:  alg,
+			}
+			rrset := []dns.RR{&dns.TXT{Hdr: dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeTXT, Class: dns.ClassINET}, Txt: []string{"foo"}}}
+
+			if err := rrsig.Sign(priv.(crypto.Signer), rrset); err != nil {
+				t.Fatalf("error signing with private key: %s", err)
+			}
+			if err := rrsig.Verify(key.DNSKEY, rrset); err != nil {
+				t.Errorf("error verifying signature with public key: %s", err)
+			}
+		})
+	}
+}
+
+func TestGenerateKeyInvalid(t *testing.T) {
+	if _, err := GenerateKey("example.com.", dns.ED25519, FlagsKSK, 2048); err == nil {
+		t.Error("expected error for Ed25519 key with 2048 bits")
+	}
+
+	if _, err := GenerateKey("example.com.", dns.RSASHA256, FlagsKSK, 8192); err == nil {
+		t.Error("expected error for RSA key with 8192 bits")
+	}
+
+	if _, err := Algorithm("DSA"); err == nil {
+		t.Error("expected error for unsupported algorithm")
+	}
+}
+
+// testKey is the DNSKEY record from RFC 4034 section 5.4.
+const testKey = "dskey.example.com. 86400 IN DNSKEY 256 3 5 ( AQOeiiR0GOMYkDshWoSKz9Xz" +
+	"fwJr1AYtsmx3TGkJaNXVbfi/2pHm822aJ5iI9BMzNXxeYCmZDRD99WYwYqUSdjMmmAphXdvxegXd" +
+	"/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9XzcnOf+EPbtG9DMBmADjFDc2w/rljwvFw== )"
+
+func TestDS(t *testing.T) {
+	key, err := dns.NewRR(testKey)
+	if err != nil {
+		t.Fatalf("error parsing DNSKEY record: %s", err)
+	}
+
+	testCases := map[string]struct {
+		digestType   uint8
+		digestLength int
+	}{
+		"SHA-1": {
+			digestType:   dns.SHA1,
+			digestLength: 40,
+		},
+		"SHA-256": {
+			digestType:   dns.SHA256,
+			digestLength: 64,
+		},
+		"SHA-384": {
+			digestType:   dns.SHA384,
+			digestLength: 96,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ds, err := DS(key.(*dns.DNSKEY), testCase.digestType)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if ds.KeyTag != 60485 || ds.Algorithm != dns.RSASHA1 || ds.DigestType != testCase.digestType || len(ds.Digest) != testCase.digestLength {
+				t.Errorf("unexpected DS record: %s", ds)
+			}
+		})
+	}
+
+	// The DS record from RFC 4034 section 5.4
+	ds, _ := DS(key.(*dns.DNSKEY), dns.SHA1)
+	if ds.Digest != strings.ToLower("2BB183AF5F22588179A53B0A98631FAD1A292118") {
+		t.Errorf("unexpected SHA-1 digest: %s", ds.Digest)
+	}
+
+	if _, err := DS(key.(*dns.DNSKEY), dns.GOST94); err == nil {
+		t.Error("expected error for unsupported digest type")
+	}
+}
+
+func TestFileName(t *testing.T) {
+	key, err := dns.NewRR(testKey)
+	if err != nil {
+		t.Fatalf("error parsing DNSKEY record: %s", err)
+	}
+
+	if name := FileName(key.(*dns.DNSKEY)); name != "Kdskey.example.com.+005+60485" {
+		t.Errorf("unexpected file name: %s", name)
+	}
+}
@@ -0,0 +1,966 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnssec
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// Status is the outcome of the validation of a lookup, as defined in RFC 4033
+// section 5. Indeterminate results are reported as bogus.
+type Status string
+
+const (
+	Secure   Status = "secure"
+	Insecure Status = "insecure"
+	Bogus    Status = "bogus"
+)
+
+// rootAnchors are the DS records of the root zone key signing keys KSK-2017
+// and KSK-2024, as published by IANA.
+var rootAnchors = []string{
+	". IN DS 20326 8 2 E06D44B80B8F1D39A95C0B0D7C65D08458E880409BBC683457104237C7F8EC8D",
+	". IN DS 38696 8 2 683D2D0ACB8C9B712A1948B27F741219298D0A450D612C483AF444A4C0FB2B16",
+}
+
+// RootAnchors returns the DS records of the root zone key signing keys.
+func RootAnchors() []dns.RR {
+	anchors, err := ParseAnchors(rootAnchors)
+	if err != nil {
+		panic(err)
+	}
+
+	return anchors
+}
+
+// ParseAnchors parses trust anchors given as DS or DNSKEY records in
+// presentation format.
+func ParseAnchors(anchors []string) ([]dns.RR, error) {
+	records := make([]dns.RR, 0, len(anchors))
+	for _, anchor := range anchors {
+		record, err := dns.NewRR(anchor)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trust anchor %q: %w", anchor, err)
+		}
+
+		switch record.(type) {
+		case *dns.DS, *dns.DNSKEY:
+			records = append(records, record)
+		default:
+			return nil, fmt.Errorf("invalid trust anchor %q: must be a DS or DNSKEY record", anchor)
+		}
+	}
+
+	return records, nil
+}
+
+// ExchangeFunc sends a query to a recursive resolver and returns its response.
+type ExchangeFunc func(msg *dns.Msg) (*dns.Msg, error)
+
+// Result is the outcome of a validated lookup.
+type Result struct {
+	// Records are the records of the answer, without their signatures. The
+	// answer includes the CNAME records leading to the queried type.
+	Records []dns.RR
+
+	Status Status
+
+	// Reason explains why the result is not secure.
+	Reason string
+}
+
+// Validator looks up records and validates them by following the chain of
+// trust from the signatures of the records up to a trust anchor, as described
+// in RFC 4035 section 5. The recursive resolver is only used to fetch records,
+// its own validation is disabled with the CD bit.
+type Validator struct {
+	exchange ExchangeFunc
+	anchors  []dns.RR
+
+	// now is the time signatures have to be valid at, the current time if zero
+	now time.Time
+
+	keys  map[string]zoneKeys
+	zones map[string]string
+}
+
+// zoneKeys are the DNSKEY records of a zone along with the status of their
+// validation.
+type zoneKeys struct {
+	keys   []*dns.DNSKEY
+	status Status
+	reason string
+}
+
+// rrset is a set of records with the same owner name and type, along with the
+// signatures covering it.
+type rrset struct {
+	records []dns.RR
+	sigs    []*dns.RRSIG
+}
+
+// bogusError is returned when the records needed to validate an answer, such
+// as the SOA records locating its zone, fail their own validation.
+type bogusError struct {
+	reason string
+}
+
+func (e *bogusError) Error() string {
+	return e.reason
+}
+
+// NewValidator returns a Validator sending queries with exchange and trusting
+// the keys of the DS or DNSKEY records in anchors.
+func NewValidator(exchange ExchangeFunc, anchors []dns.RR) *Validator {
+	return &Validator{
+		exchange: exchange,
+		anchors:  anchors,
+		keys:     make(map[string]zoneKeys),
+		zones:    make(map[string]string),
+	}
+}
+
+// Lookup looks up the records of rrType for name and validates them. A
+// negative answer is only secure if its NSEC or NSEC3 records prove that the
+// records do not exist, as described in RFC 4035 section 5.4 and RFC 5155
+// section 8.
+func (v *Validator) Lookup(name string, rrType uint16) (*Result, error) {
+	r, err := v.query(name, rrType)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{Status: Secure}
+
+	sets := splitRRsets(r.Answer)
+	for _, set := range sets {
+		result.Records = append(result.Records, set.records...)
+	}
+
+	if err := v.validateResponse(result, r, name, rrType, sets); err != nil {
+		var bogus *bogusError
+		if !errors.As(err, &bogus) {
+			return nil, err
+		}
+
+		result.Status = Bogus
+		result.Reason = bogus.reason
+	}
+
+	return result, nil
+}
+
+// validateResponse sets the status of result to the validation of r, the
+// response to the query for the records of rrType for name, whose answer
+// section is split into sets.
+func (v *Validator) validateResponse(result *Result, r *dns.Msg, name string, rrType uint16, sets []rrset) error {
+	zones := make([]string, len(sets))
+	for i, set := range sets {
+		var err error
+		zones[i], err = v.ownerZone(set.records[0].Header().Name, set.records[0].Header().Rrtype)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Records expanded from a wildcard need a proof that no closer match
+	// exists, from the zone of the wildcard
+	expanded := make(map[int]uint8)
+	for i, set := range sets {
+		if labels, ok := wildcardLabels(set, zones[i]); ok {
+			expanded[i] = labels
+
+			for _, proof := range splitRRsets(r.Ns) {
+				if t := proof.records[0].Header().Rrtype; t == dns.TypeNSEC || t == dns.TypeNSEC3 {
+					sets = append(sets, proof)
+					zones = append(zones, zones[i])
+				}
+			}
+		}
+	}
+
+	// The answer is negative when its CNAME records lead to a name without
+	// records of rrType
+	target, found := answerTarget(r.Answer, name, rrType)
+	if !found {
+		// The records of a denial of existence belong to the zone of its SOA
+		var zone string
+		for _, record := range r.Ns {
+			if record.Header().Rrtype == dns.TypeSOA && dns.IsSubDomain(record.Header().Name, target) {
+				zone = record.Header().Name
+			}
+		}
+
+		denial := splitRRsets(r.Ns)
+		if len(denial) == 0 || zone == "" {
+			return &bogusError{fmt.Sprintf("response for %s %s records has neither an answer nor a denial of existence",
+				target, dns.TypeToString[rrType])}
+		}
+
+		for _, set := range denial {
+			sets = append(sets, set)
+			zones = append(zones, zone)
+		}
+	}
+
+	for i, set := range sets {
+		status, reason, err := v.validate(set, zones[i])
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case status == Bogus && result.Status != Bogus,
+			status == Insecure && result.Status == Secure:
+			result.Status = status
+			result.Reason = reason
+		}
+	}
+
+	for i, labels := range expanded {
+		owner := sets[i].records[0].Header().Name
+		if result.Status == Secure && !provesWildcardExpansion(r.Ns, owner, labels) {
+			result.Status = Bogus
+			result.Reason = fmt.Sprintf("no proof that %s %s records expanded from a wildcard have no closer match",
+				owner, dns.TypeToString[sets[i].records[0].Header().Rrtype])
+		}
+	}
+
+	// Signed NSEC and NSEC3 records may be replayed from another response
+	if !found && result.Status == Secure && !provesDenial(r.Ns, target, rrType, r.Rcode == dns.RcodeNameError) {
+		result.Status = Bogus
+		result.Reason = fmt.Sprintf("no proof that %s has no %s records", target, dns.TypeToString[rrType])
+	}
+
+	return nil
+}
+
+// answerTarget returns the name the CNAME records of answer lead to from
+// name, and whether answer has records of rrType for that name.
+func answerTarget(answer []dns.RR, name string, rrType uint16) (string, bool) {
+	target := dns.CanonicalName(name)
+
+	// Each step follows a record, which bounds CNAME loops
+	for range len(answer) + 1 {
+		next := ""
+		for _, record := range answer {
+			if dns.CanonicalName(record.Header().Name) != target {
+				continue
+			}
+			if record.Header().Rrtype == rrType {
+				return target, true
+			}
+			if cname, ok := record.(*dns.CNAME); ok {
+				next = dns.CanonicalName(cname.Target)
+			}
+		}
+		if next == "" {
+			break
+		}
+		target = next
+	}
+
+	return target, false
+}
+
+// validate returns the status of set, verifying its signatures with the
+// keys of zone, which is the zone the records belong to. Signatures by other
+// zones, even by ancestors of zone, are not valid.
+func (v *Validator) validate(set rrset, zone string) (Status, string, error) {
+	owner := set.records[0].Header().Name
+	rrType := dns.TypeToString[set.records[0].Header().Rrtype]
+
+	if len(set.sigs) == 0 {
+		keys, err := v.zoneKeys(zone)
+		if err != nil {
+			return "", "", err
+		}
+		if keys.status == Secure {
+			return Bogus, fmt.Sprintf("%s %s records are not signed, although zone %s is", owner, rrType, zone), nil
+		}
+		return keys.status, keys.reason, nil
+	}
+
+	status := Bogus
+	reason := fmt.Sprintf("no valid signature found for %s %s records", owner, rrType)
+
+	for _, sig := range set.sigs {
+		if dns.CanonicalName(sig.SignerName) != dns.CanonicalName(zone) {
+			reason = fmt.Sprintf("signer %s of %s %s records is not their zone %s", sig.SignerName, owner, rrType, zone)
+			continue
+		}
+
+		keys, err := v.zoneKeys(sig.SignerName)
+		if err != nil {
+			return "", "", err
+		}
+		if keys.status != Secure {
+			status, reason = keys.status, keys.reason
+			continue
+		}
+
+		if !sig.ValidityPeriod(v.now) {
+			reason = fmt.Sprintf("signature of %s %s records by key %d is expired or not yet valid", owner, rrType, sig.KeyTag)
+			continue
+		}
+
+		for _, key := range keys.keys {
+			if key.KeyTag() != sig.KeyTag || key.Algorithm != sig.Algorithm {
+				continue
+			}
+			if err := sig.Verify(key, set.records); err != nil {
+				reason = fmt.Sprintf("signature of %s %s records by key %d does not verify: %s", owner, rrType, sig.KeyTag, err)
+				continue
+			}
+
+			return Secure, "", nil
+		}
+	}
+
+	return status, reason, nil
+}
+
+// zoneKeys returns the DNSKEY records of zone, authenticated with the DS
+// records in its parent zone or with a trust anchor.
+func (v *Validator) zoneKeys(zone string) (zoneKeys, error) {
+	zone = dns.CanonicalName(zone)
+
+	if keys, ok := v.keys[zone]; ok {
+		return keys, nil
+	}
+
+	// Break loops in a broken chain of trust
+	v.keys[zone] = zoneKeys{status: Bogus, reason: fmt.Sprintf("loop in the chain of trust at %s", zone)}
+
+	keys, err := v.authenticateKeys(zone)
+	if err != nil {
+		delete(v.keys, zone)
+		return zoneKeys{}, err
+	}
+
+	v.keys[zone] = keys
+	return keys, nil
+}
+
+func (v *Validator) authenticateKeys(zone string) (zoneKeys, error) {
+	var ds []*dns.DS
+	var trusted []*dns.DNSKEY
+
+	for _, anchor := range v.anchors {
+		if dns.CanonicalName(anchor.Header().Name) != zone {
+			continue
+		}
+
+		switch rr := anchor.(type) {
+		case *dns.DS:
+			ds = append(ds, rr)
+		case *dns.DNSKEY:
+			trusted = append(trusted, rr)
+		}
+	}
+
+	if len(ds) == 0 && len(trusted) == 0 {
+		if zone == "." {
+			return zoneKeys{status: Bogus, reason: "no trust anchor for the root zone"}, nil
+		}
+
+		r, err := v.query(zone, dns.TypeDS)
+		if err != nil {
+			return zoneKeys{}, err
+		}
+
+		set, ok := findRRset(r.Answer, zone, dns.TypeDS)
+		if !ok {
+			return v.authenticateNoDS(zone, r)
+		}
+
+		parent, err := v.ownerZone(zone, dns.TypeDS)
+		if err != nil {
+			return zoneKeys{}, err
+		}
+
+		status, reason, err := v.validate(set, parent)
+		if err != nil {
+			return zoneKeys{}, err
+		}
+		if status != Secure {
+			return zoneKeys{status: status, reason: reason}, nil
+		}
+
+		for _, record := range set.records {
+			ds = append(ds, record.(*dns.DS))
+		}
+	}
+
+	r, err := v.query(zone, dns.TypeDNSKEY)
+	if err != nil {
+		return zoneKeys{}, err
+	}
+
+	set, ok := findRRset(r.Answer, zone, dns.TypeDNSKEY)
+	if !ok {
+		return zoneKeys{status: Bogus, reason: fmt.Sprintf("no DNSKEY records found for zone %s", zone)}, nil
+	}
+
+	keys := make([]*dns.DNSKEY, 0, len(set.records))
+	for _, record := range set.records {
+		key := record.(*dns.DNSKEY)
+		keys = append(keys, key)
+
+		for _, d := range ds {
+			if matchesDS(key, d) {
+				trusted = append(trusted, key)
+			}
+		}
+	}
+
+	for _, sig := range set.sigs {
+		if !sig.ValidityPeriod(v.now) {
+			continue
+		}
+
+		for _, key := range trusted {
+			if key.KeyTag() == sig.KeyTag && key.Algorithm == sig.Algorithm && sig.Verify(key, set.records) == nil {
+				return zoneKeys{keys: keys, status: Secure}, nil
+			}
+		}
+	}
+
+	return zoneKeys{status: Bogus, reason: fmt.Sprintf("DNSKEY records of zone %s are not signed by a trusted key", zone)}, nil
+}
+
+// authenticateNoDS returns the status of a zone without DS records, given the
+// response to the DS query. The zone is insecure if its parent zone is, or if
+// the parent zone proves the absence of the DS records. The denial must come
+// from the zone immediately enclosing the delegation, as an ancestor zone
+// could otherwise prove the absence of DS records from another delegation.
+func (v *Validator) authenticateNoDS(zone string, r *dns.Msg) (zoneKeys, error) {
+	parent, err := v.ownerZone(zone, dns.TypeDS)
+	if err != nil {
+		return zoneKeys{}, err
+	}
+
+	var owner string
+	for _, record := range r.Ns {
+		if record.Header().Rrtype == dns.TypeSOA {
+			owner = record.Header().Name
+		}
+	}
+	if owner == "" {
+		return zoneKeys{status: Bogus, reason: fmt.Sprintf("no proof that zone %s has no DS records", zone)}, nil
+	}
+	if dns.CanonicalName(owner) != parent {
+		return zoneKeys{status: Bogus, reason: fmt.Sprintf("denial of the DS records of zone %s is from zone %s instead of its parent zone %s",
+			zone, owner, parent)}, nil
+	}
+
+	keys, err := v.zoneKeys(parent)
+	if err != nil {
+		return zoneKeys{}, err
+	}
+	if keys.status != Secure {
+		return zoneKeys{status: keys.status, reason: keys.reason}, nil
+	}
+
+	for _, set := range splitRRsets(r.Ns) {
+		status, reason, err := v.validate(set, parent)
+		if err != nil {
+			return zoneKeys{}, err
+		}
+		if status != Secure {
+			return zoneKeys{status: Bogus, reason: reason}, nil
+		}
+	}
+
+	if !provesNoDS(r.Ns, zone) {
+		return zoneKeys{status: Bogus, reason: fmt.Sprintf("no proof that zone %s has no DS records", zone)}, nil
+	}
+
+	return zoneKeys{status: Insecure, reason: fmt.Sprintf("%s is an unsigned delegation", zone)}, nil
+}
+
+// ownerZone returns the zone the records of rrType for owner belong to. DS
+// records belong to the parent zone of the delegation at their owner.
+func (v *Validator) ownerZone(owner string, rrType uint16) (string, error) {
+	if rrType == dns.TypeDS && dns.CanonicalName(owner) != "." {
+		labels := dns.SplitDomainName(owner)
+		return v.zoneOf(dns.Fqdn(strings.Join(labels[1:], ".")))
+	}
+
+	return v.zoneOf(owner)
+}
+
+// zoneOf returns the zone name belongs to. A zone apex has SOA records, while
+// the SOA records of the zone of any other name come with the denial of
+// existence of its own, which has to be proven. SOA records of other zones,
+// such as the zone of a CNAME target, are ignored.
+func (v *Validator) zoneOf(name string) (string, error) {
+	name = dns.CanonicalName(name)
+
+	if zone, ok := v.zones[name]; ok {
+		return zone, nil
+	}
+
+	r, err := v.query(name, dns.TypeSOA)
+	if err != nil {
+		return "", err
+	}
+
+	zone, err := v.authenticateZone(name, r)
+	if err != nil {
+		return "", err
+	}
+
+	v.zones[name] = zone
+	return zone, nil
+}
+
+// authenticateZone returns the zone of name, given the response to its SOA
+// query.
+func (v *Validator) authenticateZone(name string, r *dns.Msg) (string, error) {
+	if set, ok := findRRset(r.Answer, name, dns.TypeSOA); ok {
+		return name, v.requireValid(set, name)
+	}
+
+	// A CNAME record is never at a zone apex, so the zone of name is the
+	// zone of its parent
+	if set, ok := findRRset(r.Answer, name, dns.TypeCNAME); ok && name != "." {
+		labels := dns.SplitDomainName(name)
+		zone, err := v.zoneOf(dns.Fqdn(strings.Join(labels[1:], ".")))
+		if err != nil {
+			return "", err
+		}
+
+		return zone, v.requireValid(set, zone)
+	}
+
+	var zone string
+	found := false
+	for _, record := range r.Ns {
+		owner := dns.CanonicalName(record.Header().Name)
+		if record.Header().Rrtype != dns.TypeSOA || !dns.IsSubDomain(owner, name) {
+			continue
+		}
+		if !found || dns.CountLabel(owner) > dns.CountLabel(zone) {
+			zone, found = owner, true
+		}
+	}
+	if !found {
+		return "", fmt.Errorf("unable to find the zone of %s", name)
+	}
+
+	status := Secure
+	for _, set := range splitRRsets(r.Ns) {
+		s, reason, err := v.validate(set, zone)
+		if err != nil {
+			return "", err
+		}
+		if s == Bogus {
+			return "", &bogusError{reason}
+		}
+		if s == Insecure {
+			status = Insecure
+		}
+	}
+
+	if status == Secure && !provesDenial(r.Ns, name, dns.TypeSOA, r.Rcode == dns.RcodeNameError) {
+		return "", &bogusError{fmt.Sprintf("no proof that %s is not the apex of a zone below %s", name, zone)}
+	}
+
+	return zone, nil
+}
+
+// requireValid returns a bogusError if set fails its validation with the
+// keys of zone.
+func (v *Validator) requireValid(set rrset, zone string) error {
+	status, reason, err := v.validate(set, zone)
+	if err != nil {
+		return err
+	}
+	if status == Bogus {
+		return &bogusError{reason}
+	}
+
+	return nil
+}
+
+// query sends a query with the DO bit set, so signatures are returned, and
+// the CD bit set, so records failing the validation of the resolver are
+// returned too.
+func (v *Validator) query(name string, rrType uint16) (*dns.Msg, error) {
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(name), rrType)
+	msg.SetEdns0(dns.DefaultMsgSize, true)
+	msg.CheckingDisabled = true
+
+	r, err := v.exchange(msg)
+	if err != nil {
+		return nil, fmt.Errorf("error querying %s %s records: %w", name, dns.TypeToString[rrType], err)
+	}
+	if r.Rcode != dns.RcodeSuccess && r.Rcode != dns.RcodeNameError {
+		return nil, fmt.Errorf("error querying %s %s records: %s", name, dns.TypeToString[rrType], dns.RcodeToString[r.Rcode])
+	}
+
+	return r, nil
+}
+
+// splitRRsets groups records into RRsets, in the order they first appear.
+// Signatures without records are dropped.
+func splitRRsets(records []dns.RR) []rrset {
+	var sets []rrset
+	index := make(map[string]int)
+
+	key := func(name string, rrType uint16) string {
+		return dns.CanonicalName(name) + "/" + dns.TypeToString[rrType]
+	}
+
+	for _, record := range records {
+		if _, ok := record.(*dns.RRSIG); ok || record.Header().Rrtype == dns.TypeOPT {
+			continue
+		}
+
+		k := key(record.Header().Name, record.Header().Rrtype)
+		i, ok := index[k]
+		if !ok {
+			i = len(sets)
+			index[k] = i
+			sets = append(sets, rrset{})
+		}
+		sets[i].records = append(sets[i].records, record)
+	}
+
+	for _, record := range records {
+		sig, ok := record.(*dns.RRSIG)
+		if !ok {
+			continue
+		}
+
+		if i, ok := index[key(sig.Hdr.Name, sig.TypeCovered)]; ok {
+			sets[i].sigs = append(sets[i].sigs, sig)
+		}
+	}
+
+	return sets
+}
+
+// findRRset returns the RRset of rrType for name in records.
+func findRRset(records []dns.RR, name string, rrType uint16) (rrset, bool) {
+	for _, set := range splitRRsets(records) {
+		hdr := set.records[0].Header()
+		if hdr.Rrtype == rrType && dns.CanonicalName(hdr.Name) == dns.CanonicalName(name) {
+			return set, true
+		}
+	}
+
+	return rrset{}, false
+}
+
+// matchesDS reports whether ds refers to key.
+func matchesDS(key *dns.DNSKEY, ds *dns.DS) bool {
+	if key.KeyTag() != ds.KeyTag || key.Algorithm != ds.Algorithm {
+		return false
+	}
+
+	digest := key.ToDS(ds.DigestType)
+	return digest != nil && strings.EqualFold(digest.Digest, ds.Digest)
+}
+
+// provesNoDS reports whether the NSEC or NSEC3 records prove that the
+// delegation to zone has no DS records, as described in RFC 4035 section
+// 5.2 and RFC 5155 section 8.6.
+func provesNoDS(records []dns.RR, zone string) bool {
+	var nsec3 []*dns.NSEC3
+	for _, record := range records {
+		switch rr := record.(type) {
+		case *dns.NSEC:
+			if dns.CanonicalName(rr.Hdr.Name) == dns.CanonicalName(zone) &&
+				hasType(rr.TypeBitMap, dns.TypeNS) && !hasType(rr.TypeBitMap, dns.TypeDS) {
+				return true
+			}
+		case *dns.NSEC3:
+			if rr.Match(zone) && hasType(rr.TypeBitMap, dns.TypeNS) && !hasType(rr.TypeBitMap, dns.TypeDS) {
+				return true
+			}
+			nsec3 = append(nsec3, rr)
+		}
+	}
+
+	// Opt-out NSEC3 records may cover unsigned delegations, given the proof
+	// of the closest encloser of zone
+	_, optOut, ok := nsec3ClosestEncloser(nsec3, zone)
+	return ok && optOut
+}
+
+// wildcardLabels returns the number of labels of the wildcard the records of
+// set are expanded from, which is given by a signature of zone with fewer
+// labels than the owner name of the records, as described in RFC 4035
+// section 5.3.2.
+func wildcardLabels(set rrset, zone string) (uint8, bool) {
+	owner := set.records[0].Header().Name
+
+	count := dns.CountLabel(owner)
+	if strings.HasPrefix(owner, "*.") {
+		count--
+	}
+
+	for _, sig := range set.sigs {
+		if dns.CanonicalName(sig.SignerName) == dns.CanonicalName(zone) && int(sig.Labels) < count {
+			return sig.Labels, true
+		}
+	}
+
+	return 0, false
+}
+
+// provesWildcardExpansion reports whether the NSEC or NSEC3 records prove
+// that name has no closer match than the wildcard with the given number of
+// labels, as described in RFC 4035 section 5.3.4 and RFC 5155 section 8.8.
+func provesWildcardExpansion(records []dns.RR, name string, labels uint8) bool {
+	names := dns.SplitDomainName(name)
+	if int(labels) >= len(names) {
+		return false
+	}
+
+	encloser := dns.Fqdn(strings.Join(names[len(names)-int(labels):], "."))
+	nextCloser := dns.Fqdn(strings.Join(names[len(names)-int(labels)-1:], "."))
+
+	for _, record := range records {
+		switch rr := record.(type) {
+		case *dns.NSEC:
+			if nsecCovers(rr, name) && nsecEncloser(rr, name) == dns.CanonicalName(encloser) {
+				return true
+			}
+		case *dns.NSEC3:
+			if nsec3Covers(rr, nextCloser) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// provesDenial reports whether the NSEC or NSEC3 records prove that name has
+// no records of rrType, or that it does not exist if nxdomain is set, as
+// described in RFC 4035 section 5.4 and RFC 5155 sections 8.4 to 8.7.
+func provesDenial(records []dns.RR, name string, rrType uint16, nxdomain bool) bool {
+	var nsec []*dns.NSEC
+	var nsec3 []*dns.NSEC3
+	for _, record := range records {
+		switch rr := record.(type) {
+		case *dns.NSEC:
+			nsec = append(nsec, rr)
+		case *dns.NSEC3:
+			nsec3 = append(nsec3, rr)
+		}
+	}
+
+	if len(nsec) > 0 {
+		return nsecProvesDenial(nsec, name, rrType, nxdomain)
+	}
+
+	return nsec3ProvesDenial(nsec3, name, rrType, nxdomain)
+}
+
+func nsecProvesDenial(records []*dns.NSEC, name string, rrType uint16, nxdomain bool) bool {
+	name = dns.CanonicalName(name)
+
+	if !nxdomain {
+		for _, rr := range records {
+			if dns.CanonicalName(rr.Hdr.Name) == name {
+				return deniesType(rr.TypeBitMap, rrType)
+			}
+		}
+	}
+
+	for _, covering := range records {
+		if !nsecCovers(covering, name) {
+			continue
+		}
+
+		// An empty non-terminal exists, without any records
+		next := dns.CanonicalName(covering.NextDomain)
+		if next != name && dns.IsSubDomain(name, next) {
+			return !nxdomain
+		}
+
+		// Neither may a wildcard at the closest encloser of name match it
+		wildcard := wildcardName(nsecEncloser(covering, name))
+		for _, rr := range records {
+			switch {
+			case nxdomain && nsecCovers(rr, wildcard):
+				return true
+			case !nxdomain && dns.CanonicalName(rr.Hdr.Name) == wildcard && deniesType(rr.TypeBitMap, rrType):
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// nsecCovers reports whether name is between the owner and the next name of
+// rr in the canonical order. The last NSEC record of a zone covers the names
+// of the zone after its owner. A delegation does not cover the names below
+// it, as they are in the child zone.
+func nsecCovers(rr *dns.NSEC, name string) bool {
+	owner, next := dns.CanonicalName(rr.Hdr.Name), dns.CanonicalName(rr.NextDomain)
+
+	if dns.IsSubDomain(owner, name) && (hasType(rr.TypeBitMap, dns.TypeDNAME) ||
+		hasType(rr.TypeBitMap, dns.TypeNS) && !hasType(rr.TypeBitMap, dns.TypeSOA)) {
+		return false
+	}
+
+	if compareNames(owner, name) >= 0 {
+		return false
+	}
+	if compareNames(owner, next) >= 0 {
+		return dns.IsSubDomain(next, name)
+	}
+
+	return compareNames(name, next) < 0
+}
+
+// nsecEncloser returns the closest encloser of name proven by the NSEC
+// record covering it, the longest ancestor it shares with the owner or the
+// next name of the record.
+func nsecEncloser(rr *dns.NSEC, name string) string {
+	owner, next := commonAncestor(name, rr.Hdr.Name), commonAncestor(name, rr.NextDomain)
+	if dns.CountLabel(next) > dns.CountLabel(owner) {
+		return next
+	}
+
+	return owner
+}
+
+func nsec3ProvesDenial(records []*dns.NSEC3, name string, rrType uint16, nxdomain bool) bool {
+	if !nxdomain {
+		for _, rr := range records {
+			if rr.Match(name) {
+				return deniesType(rr.TypeBitMap, rrType)
+			}
+		}
+	}
+
+	encloser, optOut, ok := nsec3ClosestEncloser(records, name)
+	if !ok {
+		return false
+	}
+
+	wildcard := wildcardName(encloser)
+	for _, rr := range records {
+		switch {
+		case nxdomain && nsec3Covers(rr, wildcard):
+			return true
+		case !nxdomain && rr.Match(wildcard) && deniesType(rr.TypeBitMap, rrType):
+			return true
+		}
+	}
+
+	// Opt-out NSEC3 records may cover unsigned delegations
+	return !nxdomain && rrType == dns.TypeDS && optOut
+}
+
+// nsec3ClosestEncloser returns the closest encloser of name, proven by an
+// NSEC3 record matching it and another one covering the next closer name as
+// described in RFC 5155 section 8.3, along with whether the covering record
+// has the opt-out flag.
+func nsec3ClosestEncloser(records []*dns.NSEC3, name string) (string, bool, bool) {
+	labels := dns.SplitDomainName(name)
+
+	for i := 1; i <= len(labels); i++ {
+		encloser := dns.Fqdn(strings.Join(labels[i:], "."))
+
+		var match *dns.NSEC3
+		for _, rr := range records {
+			if rr.Match(encloser) {
+				match = rr
+				break
+			}
+		}
+		if match == nil {
+			continue
+		}
+
+		// The names below a delegation or a DNAME record are not in the zone
+		if hasType(match.TypeBitMap, dns.TypeDNAME) ||
+			hasType(match.TypeBitMap, dns.TypeNS) && !hasType(match.TypeBitMap, dns.TypeSOA) {
+			return "", false, false
+		}
+
+		nextCloser := dns.Fqdn(strings.Join(labels[i-1:], "."))
+		for _, rr := range records {
+			if nsec3Covers(rr, nextCloser) {
+				return encloser, rr.Flags&1 == 1, true
+			}
+		}
+
+		return "", false, false
+	}
+
+	return "", false, false
+}
+
+// nsec3Covers reports whether the hash of name is strictly between the owner
+// and the next hash of rr, which Cover alone does not ensure.
+func nsec3Covers(rr *dns.NSEC3, name string) bool {
+	return rr.Cover(name) && !rr.Match(name)
+}
+
+// deniesType reports whether the type bitmap of the NSEC or NSEC3 record of
+// a name proves that it has no records of rrType. The bitmap of a delegation
+// only proves the absence of DS records, as the other records of its name
+// are in the child zone.
+func deniesType(bitmap []uint16, rrType uint16) bool {
+	if hasType(bitmap, rrType) || hasType(bitmap, dns.TypeCNAME) {
+		return false
+	}
+
+	return rrType == dns.TypeDS || !hasType(bitmap, dns.TypeNS) || hasType(bitmap, dns.TypeSOA)
+}
+
+// wildcardName returns the name of the wildcard at encloser.
+func wildcardName(encloser string) string {
+	if encloser == "." {
+		return "*."
+	}
+
+	return "*." + dns.CanonicalName(encloser)
+}
+
+// commonAncestor returns the longest name both a and b are in.
+func commonAncestor(a, b string) string {
+	labels := dns.SplitDomainName(dns.CanonicalName(a))
+	return dns.Fqdn(strings.Join(labels[len(labels)-dns.CompareDomainName(a, b):], "."))
+}
+
+// compareNames compares a and b in the canonical order of RFC 4034 section
+// 6.1, label by label from the root, ignoring case.
+func compareNames(a, b string) int {
+	labelsA := dns.SplitDomainName(dns.CanonicalName(a))
+	labelsB := dns.SplitDomainName(dns.CanonicalName(b))
+
+	for i, j := len(labelsA)-1, len(labelsB)-1; i >= 0 && j >= 0; i, j = i-1, j-1 {
+		if c := strings.Compare(labelsA[i], labelsB[j]); c != 0 {
+			return c
+		}
+	}
+
+	return len(labelsA) - len(labelsB)
+}
+
+func hasType(bitmap []uint16, rrType uint16) bool {
+	for _, t := range bitmap {
+		if t == rrType {
+			return true
+		}
+	}
+
+	return false
+}
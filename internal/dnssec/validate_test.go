@@ -0,0 +1,758 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnssec
+
+import (
+	"crypto"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// testZone is a zone served by testResolver, signed if it has a key.
+type testZone struct {
+	name    string
+	key     *dns.DNSKEY
+	signer  crypto.Signer
+	records []dns.RR
+}
+
+// testResolver answers queries from a hierarchy of zones, like a recursive
+// resolver with validation disabled.
+type testResolver struct {
+	t     *testing.T
+	zones []*testZone
+}
+
+func newTestZone(t *testing.T, name string, signed bool, records ...string) *testZone {
+	t.Helper()
+
+	zone := &testZone{name: name}
+	records = append(records, name+" 3600 IN SOA ns."+strings.TrimPrefix(name, ".")+" hostmaster."+strings.TrimPrefix(name, ".")+" 1 3600 600 86400 300")
+
+	if signed {
+		key, err := GenerateKey(name, dns.ED25519, FlagsKSK, DefaultBits(dns.ED25519))
+		if err != nil {
+			t.Fatalf("error generating key: %s", err)
+		}
+
+		priv, err := key.DNSKEY.NewPrivateKey(key.PrivateKey)
+		if err != nil {
+			t.Fatalf("error parsing private key: %s", err)
+		}
+
+		zone.key = key.DNSKEY
+		zone.signer = priv.(crypto.Signer)
+		zone.records = append(zone.records, key.DNSKEY)
+	}
+
+	for _, record := range records {
+		rr, err := dns.NewRR(record)
+		if err != nil {
+			t.Fatalf("error parsing %q: %s", record, err)
+		}
+		zone.records = append(zone.records, rr)
+	}
+
+	return zone
+}
+
+// delegate adds the records of the delegation to child in zone.
+func (zone *testZone) delegate(t *testing.T, child *testZone) {
+	t.Helper()
+
+	ns, err := dns.NewRR(child.name + " 3600 IN NS ns." + child.name)
+	if err != nil {
+		t.Fatalf("error parsing NS record: %s", err)
+	}
+	zone.records = append(zone.records, ns)
+
+	if child.key != nil {
+		zone.records = append(zone.records, child.key.ToDS(dns.SHA256))
+	}
+}
+
+// names returns the owner names of zone in the canonical order.
+func (zone *testZone) names() []string {
+	var names []string
+	for _, record := range zone.records {
+		if !slices.Contains(names, record.Header().Name) {
+			names = append(names, record.Header().Name)
+		}
+	}
+
+	slices.SortFunc(names, compareNames)
+	return names
+}
+
+// exists reports whether name has records in zone, or is an empty
+// non-terminal.
+func (zone *testZone) exists(name string) bool {
+	for _, owner := range zone.names() {
+		if dns.IsSubDomain(name, owner) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// encloser returns the closest ancestor of name which exists in zone.
+func (zone *testZone) encloser(name string) string {
+	for !zone.exists(name) {
+		labels := dns.SplitDomainName(name)
+		name = dns.Fqdn(strings.Join(labels[1:], "."))
+	}
+
+	return name
+}
+
+// nsec returns the NSEC chain of zone, which is only signed if it has a key.
+func (zone *testZone) nsec() []dns.RR {
+	if zone.key == nil {
+		return nil
+	}
+
+	names := zone.names()
+	chain := make([]dns.RR, 0, len(names))
+	for i, name := range names {
+		bitmap := []uint16{dns.TypeRRSIG, dns.TypeNSEC}
+		for _, record := range zone.records {
+			if record.Header().Name == name && !slices.Contains(bitmap, record.Header().Rrtype) {
+				bitmap = append(bitmap, record.Header().Rrtype)
+			}
+		}
+		slices.Sort(bitmap)
+
+		chain = append(chain, &dns.NSEC{
+			Hdr:        dns.RR_Header{Name: name, Rrtype: dns.TypeNSEC, Class: dns.ClassINET, Ttl: 300},
+			NextDomain: names[(i+1)%len(names)],
+			TypeBitMap: bitmap,
+		})
+	}
+
+	return chain
+}
+
+// covering returns the signed NSEC record of zone covering name.
+func (zone *testZone) covering(t *testing.T, name string) []dns.RR {
+	t.Helper()
+
+	chain := zone.nsec()
+	for i := len(chain) - 1; i >= 0; i-- {
+		if compareNames(chain[i].Header().Name, name) < 0 {
+			return zone.sign(t, chain[i:i+1])
+		}
+	}
+
+	return zone.sign(t, chain[len(chain)-1:])
+}
+
+// rrset returns the records of rrType for name, along with their signature.
+func (zone *testZone) rrset(t *testing.T, name string, rrType uint16) []dns.RR {
+	t.Helper()
+
+	var records []dns.RR
+	for _, record := range append(zone.nsec(), zone.records...) {
+		if record.Header().Name == name && record.Header().Rrtype == rrType {
+			records = append(records, record)
+		}
+	}
+
+	return zone.sign(t, records)
+}
+
+// sign returns records along with their signature by the key of zone.
+func (zone *testZone) sign(t *testing.T, records []dns.RR) []dns.RR {
+	t.Helper()
+
+	if len(records) == 0 || zone.key == nil {
+		return records
+	}
+
+	now := time.Now()
+	sig := &dns.RRSIG{
+		Hdr:        dns.RR_Header{Name: records[0].Header().Name, Rrtype: dns.TypeRRSIG, Class: dns.ClassINET, Ttl: records[0].Header().Ttl},
+		Algorithm:  zone.key.Algorithm,
+		SignerName: zone.name,
+		KeyTag:     zone.key.KeyTag(),
+		Inception:  uint32(now.Add(-time.Hour).Unix()),
+		Expiration: uint32(now.Add(time.Hour).Unix()),
+	}
+	if err := sig.Sign(zone.signer, records); err != nil {
+		t.Fatalf("error signing %s records: %s", dns.TypeToString[records[0].Header().Rrtype], err)
+	}
+
+	return append(records, sig)
+}
+
+func (resolver *testResolver) exchange(msg *dns.Msg) (*dns.Msg, error) {
+	question := msg.Question[0]
+
+	// The zone with the longest name containing the question, or the
+	// parent zone for DS records
+	var zone *testZone
+	for _, z := range resolver.zones {
+		if !dns.IsSubDomain(z.name, question.Name) {
+			continue
+		}
+		if question.Qtype == dns.TypeDS && z.name == question.Name && z.name != "." {
+			continue
+		}
+		if zone == nil || dns.CountLabel(z.name) > dns.CountLabel(zone.name) {
+			zone = z
+		}
+	}
+
+	r := new(dns.Msg)
+	r.SetReply(msg)
+
+	r.Answer = zone.rrset(resolver.t, question.Name, question.Qtype)
+
+	// CNAME records are followed within the zone
+	if cname := zone.rrset(resolver.t, question.Name, dns.TypeCNAME); len(r.Answer) == 0 && len(cname) > 0 {
+		r.Answer = append(cname, zone.rrset(resolver.t, cname[0].(*dns.CNAME).Target, question.Qtype)...)
+	}
+
+	// Names which do not exist match the wildcard at their closest encloser
+	var wildcard string
+	if !zone.exists(question.Name) && zone.exists(wildcardName(zone.encloser(question.Name))) {
+		wildcard = wildcardName(zone.encloser(question.Name))
+
+		for _, record := range zone.rrset(resolver.t, wildcard, question.Qtype) {
+			record = dns.Copy(record)
+			record.Header().Name = question.Name
+			r.Answer = append(r.Answer, record)
+		}
+
+		// The proof that the name does not exist
+		if len(r.Answer) > 0 && !strings.HasPrefix(question.Name, "unproven.") {
+			r.Ns = append(r.Ns, zone.covering(resolver.t, question.Name)...)
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(question.Name, "tampered."):
+		for i, record := range r.Answer {
+			if a, ok := record.(*dns.A); ok {
+				a = dns.Copy(a).(*dns.A)
+				a.A = a.A.To4()
+				a.A[3]++
+				r.Answer[i] = a
+			}
+		}
+	case strings.HasPrefix(question.Name, "unsigned."), strings.HasPrefix(question.Name, "ancestor."):
+		answer := r.Answer[:0]
+		for _, record := range r.Answer {
+			if _, ok := record.(*dns.RRSIG); !ok {
+				answer = append(answer, record)
+			}
+		}
+		r.Answer = answer
+
+		// Signed by the root zone instead of the zone of the records
+		if strings.HasPrefix(question.Name, "ancestor.") {
+			r.Answer = resolver.zones[0].sign(resolver.t, r.Answer)
+		}
+	}
+
+	if len(r.Answer) == 0 {
+		// The SOA record of an ancestor zone with the denial of DS records
+		soa := zone
+		if question.Qtype == dns.TypeDS && strings.HasPrefix(question.Name, "wrongparent.") {
+			soa = resolver.zones[0]
+		}
+		r.Ns = append(r.Ns, soa.rrset(resolver.t, soa.name, dns.TypeSOA)...)
+
+		switch {
+		case zone.key == nil:
+		case strings.HasPrefix(question.Name, "replayed."):
+			// The signed NSEC record of another name
+			r.Ns = append(r.Ns, zone.rrset(resolver.t, "www."+zone.name, dns.TypeNSEC)...)
+		case zone.exists(question.Name):
+			r.Ns = append(r.Ns, zone.rrset(resolver.t, question.Name, dns.TypeNSEC)...)
+		case wildcard != "":
+			covering := zone.covering(resolver.t, question.Name)
+			r.Ns = append(r.Ns, covering...)
+			if covering[0].Header().Name != wildcard {
+				r.Ns = append(r.Ns, zone.rrset(resolver.t, wildcard, dns.TypeNSEC)...)
+			}
+		default:
+			r.Rcode = dns.RcodeNameError
+			r.Ns = append(r.Ns, zone.covering(resolver.t, question.Name)...)
+			r.Ns = append(r.Ns, zone.covering(resolver.t, wildcardName(zone.encloser(question.Name)))...)
+		}
+	}
+
+	return r, nil
+}
+
+func TestValidatorLookup(t *testing.T) {
+	root := newTestZone(t, ".", true)
+	example := newTestZone(t, "example.", true,
+		"www.example. 300 IN A 192.0.2.1",
+		"tampered.example. 300 IN A 192.0.2.2",
+		"unsigned.example. 300 IN A 192.0.2.3",
+		"ancestor.example. 300 IN A 192.0.2.5",
+		"alias.example. 300 IN CNAME www.example.",
+		"*.wild.example. 300 IN A 192.0.2.6",
+	)
+	insecure := newTestZone(t, "insecure.example.", false,
+		"www.insecure.example. 300 IN A 192.0.2.4",
+	)
+	wrongParent := newTestZone(t, "wrongparent.example.", false,
+		"www.wrongparent.example. 300 IN A 192.0.2.7",
+	)
+	root.delegate(t, example)
+	example.delegate(t, insecure)
+	example.delegate(t, wrongParent)
+
+	resolver := &testResolver{t: t, zones: []*testZone{root, example, insecure, wrongParent}}
+
+	// A root key the zones are not signed with
+	other := newTestZone(t, ".", true)
+
+	testCases := map[string]struct {
+		anchors         []dns.RR
+		now             time.Time
+		name            string
+		rrType          uint16
+		expectedStatus  Status
+		expectedRecords int
+	}{
+		"secure": {
+			name:            "www.example.",
+			rrType:          dns.TypeA,
+			expectedStatus:  Secure,
+			expectedRecords: 1,
+		},
+		"secure-cname": {
+			name:            "alias.example.",
+			rrType:          dns.TypeCNAME,
+			expectedStatus:  Secure,
+			expectedRecords: 1,
+		},
+		"secure-nxdomain": {
+			name:           "missing.example.",
+			rrType:         dns.TypeA,
+			expectedStatus: Secure,
+		},
+		"secure-nodata": {
+			name:           "www.example.",
+			rrType:         dns.TypeTXT,
+			expectedStatus: Secure,
+		},
+		"replayed": {
+			name:           "replayed.example.",
+			rrType:         dns.TypeA,
+			expectedStatus: Bogus,
+		},
+		"wildcard": {
+			name:            "host.wild.example.",
+			rrType:          dns.TypeA,
+			expectedStatus:  Secure,
+			expectedRecords: 1,
+		},
+		"wildcard-nodata": {
+			name:           "host.wild.example.",
+			rrType:         dns.TypeTXT,
+			expectedStatus: Secure,
+		},
+		"wildcard-unproven": {
+			name:            "unproven.wild.example.",
+			rrType:          dns.TypeA,
+			expectedStatus:  Bogus,
+			expectedRecords: 1,
+		},
+		"insecure": {
+			name:            "www.insecure.example.",
+			rrType:          dns.TypeA,
+			expectedStatus:  Insecure,
+			expectedRecords: 1,
+		},
+		"wrong-parent": {
+			name:            "www.wrongparent.example.",
+			rrType:          dns.TypeA,
+			expectedStatus:  Bogus,
+			expectedRecords: 1,
+		},
+		"tampered": {
+			name:            "tampered.example.",
+			rrType:          dns.TypeA,
+			expectedStatus:  Bogus,
+			expectedRecords: 1,
+		},
+		"unsigned": {
+			name:            "unsigned.example.",
+			rrType:          dns.TypeA,
+			expectedStatus:  Bogus,
+			expectedRecords: 1,
+		},
+		"ancestor": {
+			name:            "ancestor.example.",
+			rrType:          dns.TypeA,
+			expectedStatus:  Bogus,
+			expectedRecords: 1,
+		},
+		"expired": {
+			now:             time.Now().Add(24 * time.Hour),
+			name:            "www.example.",
+			rrType:          dns.TypeA,
+			expectedStatus:  Bogus,
+			expectedRecords: 1,
+		},
+		"dnskey-anchor": {
+			anchors:         []dns.RR{example.key},
+			name:            "www.example.",
+			rrType:          dns.TypeA,
+			expectedStatus:  Secure,
+			expectedRecords: 1,
+		},
+		"wrong-anchor": {
+			anchors:         []dns.RR{other.key.ToDS(dns.SHA256)},
+			name:            "www.example.",
+			rrType:          dns.TypeA,
+			expectedStatus:  Bogus,
+			expectedRecords: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			anchors := testCase.anchors
+			if anchors == nil {
+				anchors = []dns.RR{root.key.ToDS(dns.SHA256)}
+			}
+
+			validator := NewValidator(resolver.exchange, anchors)
+			validator.now = testCase.now
+
+			result, err := validator.Lookup(testCase.name, testCase.rrType)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if result.Status != testCase.expectedStatus {
+				t.Errorf("expected status %s, got %s: %s", testCase.expectedStatus, result.Status, result.Reason)
+			}
+
+			if testCase.expectedStatus != Secure && result.Reason == "" {
+				t.Error("expected a reason, got none")
+			}
+
+			if len(result.Records) != testCase.expectedRecords {
+				t.Errorf("expected %d records, got %d: %v", testCase.expectedRecords, len(result.Records), result.Records)
+			}
+		})
+	}
+}
+
+func TestProvesDenial(t *testing.T) {
+	nsec := func(owner, next string, types ...uint16) dns.RR {
+		return &dns.NSEC{
+			Hdr:        dns.RR_Header{Name: owner, Rrtype: dns.TypeNSEC, Class: dns.ClassINET},
+			NextDomain: next,
+			TypeBitMap: types,
+		}
+	}
+
+	// The NSEC3 chain of a zone with example., www.example. and the
+	// delegation child.example.
+	hashes := map[string][]uint16{
+		"example.":       {dns.TypeSOA, dns.TypeNS},
+		"www.example.":   {dns.TypeA},
+		"child.example.": {dns.TypeNS},
+	}
+	var owners []string
+	for name := range hashes {
+		owners = append(owners, dns.HashName(name, dns.SHA1, 0, ""))
+	}
+	slices.Sort(owners)
+
+	var nsec3 []dns.RR
+	for name, types := range hashes {
+		hash := dns.HashName(name, dns.SHA1, 0, "")
+		i := slices.Index(owners, hash)
+		nsec3 = append(nsec3, &dns.NSEC3{
+			Hdr:        dns.RR_Header{Name: strings.ToLower(hash) + ".example.", Rrtype: dns.TypeNSEC3, Class: dns.ClassINET},
+			Hash:       dns.SHA1,
+			NextDomain: owners[(i+1)%len(owners)],
+			TypeBitMap: types,
+		})
+	}
+
+	testCases := map[string]struct {
+		records  []dns.RR
+		name     string
+		rrType   uint16
+		nxdomain bool
+		expected bool
+	}{
+		"nsec-nodata": {
+			records:  []dns.RR{nsec("www.example.", "zz.example.", dns.TypeA)},
+			name:     "www.example.",
+			rrType:   dns.TypeTXT,
+			expected: true,
+		},
+		"nsec-nodata-existing-type": {
+			records: []dns.RR{nsec("www.example.", "zz.example.", dns.TypeA)},
+			name:    "www.example.",
+			rrType:  dns.TypeA,
+		},
+		"nsec-nodata-delegation": {
+			records: []dns.RR{nsec("child.example.", "www.example.", dns.TypeNS)},
+			name:    "child.example.",
+			rrType:  dns.TypeA,
+		},
+		"nsec-nodata-empty-non-terminal": {
+			records:  []dns.RR{nsec("a.example.", "www.b.example.", dns.TypeA)},
+			name:     "b.example.",
+			rrType:   dns.TypeA,
+			expected: true,
+		},
+		"nsec-nxdomain": {
+			records: []dns.RR{
+				nsec("example.", "www.example.", dns.TypeSOA),
+				nsec("www.example.", "example.", dns.TypeA),
+			},
+			name:     "missing.example.",
+			rrType:   dns.TypeA,
+			nxdomain: true,
+			expected: true,
+		},
+		"nsec-nxdomain-without-wildcard": {
+			records:  []dns.RR{nsec("www.example.", "example.", dns.TypeA)},
+			name:     "zz.example.",
+			rrType:   dns.TypeA,
+			nxdomain: true,
+		},
+		"nsec-nxdomain-empty-non-terminal": {
+			records: []dns.RR{
+				nsec("example.", "a.example.", dns.TypeSOA),
+				nsec("a.example.", "www.b.example.", dns.TypeA),
+			},
+			name:     "b.example.",
+			rrType:   dns.TypeA,
+			nxdomain: true,
+		},
+		"nsec-nxdomain-below-delegation": {
+			records: []dns.RR{
+				nsec("example.", "child.example.", dns.TypeSOA),
+				nsec("child.example.", "www.example.", dns.TypeNS),
+			},
+			name:     "www.child.example.",
+			rrType:   dns.TypeA,
+			nxdomain: true,
+		},
+		"nsec3-nodata": {
+			records:  nsec3,
+			name:     "www.example.",
+			rrType:   dns.TypeTXT,
+			expected: true,
+		},
+		"nsec3-nodata-existing-type": {
+			records: nsec3,
+			name:    "www.example.",
+			rrType:  dns.TypeA,
+		},
+		"nsec3-nxdomain": {
+			records:  nsec3,
+			name:     "missing.example.",
+			rrType:   dns.TypeA,
+			nxdomain: true,
+			expected: true,
+		},
+		"nsec3-nxdomain-existing-name": {
+			records:  nsec3,
+			name:     "www.example.",
+			rrType:   dns.TypeA,
+			nxdomain: true,
+		},
+		"nsec3-nxdomain-below-delegation": {
+			records:  nsec3,
+			name:     "www.child.example.",
+			rrType:   dns.TypeA,
+			nxdomain: true,
+		},
+		"none": {
+			name:     "missing.example.",
+			rrType:   dns.TypeA,
+			nxdomain: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := provesDenial(testCase.records, testCase.name, testCase.rrType, testCase.nxdomain)
+			if actual != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestProvesWildcardExpansion(t *testing.T) {
+	nsec := func(owner, next string) dns.RR {
+		return &dns.NSEC{
+			Hdr:        dns.RR_Header{Name: owner, Rrtype: dns.TypeNSEC, Class: dns.ClassINET},
+			NextDomain: next,
+			TypeBitMap: []uint16{dns.TypeA},
+		}
+	}
+	nsec3 := func(owner, next string) dns.RR {
+		return &dns.NSEC3{
+			Hdr:        dns.RR_Header{Name: strings.ToLower(dns.HashName(owner, dns.SHA1, 0, "")) + ".example.", Rrtype: dns.TypeNSEC3, Class: dns.ClassINET},
+			Hash:       dns.SHA1,
+			NextDomain: dns.HashName(next, dns.SHA1, 0, ""),
+		}
+	}
+
+	testCases := map[string]struct {
+		records  []dns.RR
+		name     string
+		expected bool
+	}{
+		"nsec": {
+			records:  []dns.RR{nsec("*.example.", "www.example.")},
+			name:     "host.example.",
+			expected: true,
+		},
+		// b.example. is a closer match than the wildcard of example.
+		"nsec-closer-match": {
+			records: []dns.RR{nsec("a.b.example.", "www.example.")},
+			name:    "host.b.example.",
+		},
+		"nsec3": {
+			// The same owner and next hash cover every other name
+			records:  []dns.RR{nsec3("other.example.", "other.example.")},
+			name:     "host.example.",
+			expected: true,
+		},
+		"none": {
+			name: "host.example.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := provesWildcardExpansion(testCase.records, testCase.name, 1)
+			if actual != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestProvesNoDS(t *testing.T) {
+	nsec3 := func(owner, next string, optOut bool, types ...uint16) dns.RR {
+		rr := &dns.NSEC3{
+			Hdr:        dns.RR_Header{Name: strings.ToLower(owner) + ".example.", Rrtype: dns.TypeNSEC3, Class: dns.ClassINET},
+			Hash:       dns.SHA1,
+			NextDomain: next,
+			TypeBitMap: types,
+		}
+		if optOut {
+			rr.Flags = 1
+		}
+		return rr
+	}
+	hash := func(name string) string {
+		return dns.HashName(name, dns.SHA1, 0, "")
+	}
+
+	// The NSEC3 chain of a zone with example. and the signed delegation
+	// child.example., skipping unsigned delegations with opt-out
+	chain := func(optOut bool) []dns.RR {
+		apex, child := hash("example."), hash("child.example.")
+		return []dns.RR{
+			nsec3(apex, child, optOut, dns.TypeSOA, dns.TypeNS),
+			nsec3(child, apex, optOut, dns.TypeNS, dns.TypeDS),
+		}
+	}
+
+	testCases := map[string]struct {
+		records  []dns.RR
+		zone     string
+		expected bool
+	}{
+		"nsec": {
+			records: []dns.RR{&dns.NSEC{
+				Hdr:        dns.RR_Header{Name: "unsigned.example.", Rrtype: dns.TypeNSEC, Class: dns.ClassINET},
+				NextDomain: "zz.example.",
+				TypeBitMap: []uint16{dns.TypeNS},
+			}},
+			zone:     "unsigned.example.",
+			expected: true,
+		},
+		"nsec-ds": {
+			records: []dns.RR{&dns.NSEC{
+				Hdr:        dns.RR_Header{Name: "child.example.", Rrtype: dns.TypeNSEC, Class: dns.ClassINET},
+				NextDomain: "zz.example.",
+				TypeBitMap: []uint16{dns.TypeNS, dns.TypeDS},
+			}},
+			zone: "child.example.",
+		},
+		"nsec3": {
+			records:  []dns.RR{nsec3(hash("unsigned.example."), hash("zz.example."), false, dns.TypeNS)},
+			zone:     "unsigned.example.",
+			expected: true,
+		},
+		"nsec3-ds": {
+			records: chain(false),
+			zone:    "child.example.",
+		},
+		"nsec3-opt-out": {
+			records:  chain(true),
+			zone:     "unsigned.example.",
+			expected: true,
+		},
+		"nsec3-without-opt-out": {
+			records: chain(false),
+			zone:    "unsigned.example.",
+		},
+		// An opt-out record covering the delegation, without the proof of
+		// its closest encloser
+		"nsec3-forged-opt-out": {
+			records: []dns.RR{nsec3(hash("other.example."), hash("other.example."), true)},
+			zone:    "unsigned.example.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := provesNoDS(testCase.records, testCase.zone)
+			if actual != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestParseAnchors(t *testing.T) {
+	if len(RootAnchors()) != 2 {
+		t.Errorf("expected 2 root anchors, got %d", len(RootAnchors()))
+	}
+
+	anchors, err := ParseAnchors([]string{
+		"example.com. IN DNSKEY 257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4=",
+		"example.com. IN DS 370 13 2 BE74359954660069D5C63D200C39F5603827D7DD02B56F120EE9F3A86764247C",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(anchors) != 2 {
+		t.Errorf("expected 2 anchors, got %d", len(anchors))
+	}
+
+	if _, err := ParseAnchors([]string{"example.com. IN A 192.0.2.1"}); err == nil {
+		t.Error("expected error for A record, got none")
+	}
+
+	if _, err := ParseAnchors([]string{"not a record"}); err == nil {
+		t.Error("expected error for invalid record, got none")
+	}
+}
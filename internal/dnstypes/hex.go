@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = (*HexType)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*Hex)(nil)
+	_ xattr.ValidateableAttribute                = (*Hex)(nil)
+)
+
+// HexType is a string type holding hexadecimal encoded binary data, such as
+// the digest of a DS record.
+type HexType struct {
+	basetypes.StringType
+}
+
+func (t HexType) String() string {
+	return "dnstypes.HexType"
+}
+
+func (t HexType) ValueType(ctx context.Context) attr.Value {
+	return Hex{}
+}
+
+func (t HexType) Equal(o attr.Type) bool {
+	other, ok := o.(HexType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t HexType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Hex{StringValue: in}, nil
+}
+
+func (t HexType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// Hex is hexadecimal encoded binary data. Two values are semantically equal
+// when they encode the same data, regardless of the case of the digits.
+type Hex struct {
+	basetypes.StringValue
+}
+
+func (v Hex) Type(ctx context.Context) attr.Type {
+	return HexType{}
+}
+
+func (v Hex) Equal(o attr.Value) bool {
+	other, ok := o.(Hex)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v Hex) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Hex)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return strings.EqualFold(v.ValueString(), newValue.ValueString()), diags
+}
+
+func (v Hex) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := hex.DecodeString(v.ValueString()); err != nil || v.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Hex String Value",
+			"A string value was provided that is not an even number of hexadecimal digits.\n\n"+
+				"Given Value: "+v.ValueString(),
+		)
+	}
+}
+
+// NewHexNull creates a Hex with a null value.
+func NewHexNull() Hex {
+	return Hex{StringValue: basetypes.NewStringNull()}
+}
+
+// NewHexUnknown creates a Hex with an unknown value.
+func NewHexUnknown() Hex {
+	return Hex{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewHexValue creates a Hex with a known value.
+func NewHexValue(value string) Hex {
+	return Hex{StringValue: basetypes.NewStringValue(value)}
+}
@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestHexStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentValue  Hex
+		givenValue    basetypes.StringValuable
+		expectedMatch bool
+	}{
+		"equal": {
+			currentValue:  NewHexValue("2bb183af5f22"),
+			givenValue:    NewHexValue("2bb183af5f22"),
+			expectedMatch: true,
+		},
+		"case": {
+			currentValue:  NewHexValue("2BB183AF5F22"),
+			givenValue:    NewHexValue("2bb183af5f22"),
+			expectedMatch: true,
+		},
+		"different": {
+			currentValue:  NewHexValue("2bb183af5f22"),
+			givenValue:    NewHexValue("2bb183af5f23"),
+			expectedMatch: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentValue.StringSemanticEquals(context.Background(), testCase.givenValue)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if match != testCase.expectedMatch {
+				t.Errorf("expected match %t, got %t", testCase.expectedMatch, match)
+			}
+		})
+	}
+}
+
+func TestHexValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       Hex
+		expectError bool
+	}{
+		"null": {
+			value: NewHexNull(),
+		},
+		"unknown": {
+			value: NewHexUnknown(),
+		},
+		"lowercase": {
+			value: NewHexValue("2bb183af"),
+		},
+		"uppercase": {
+			value: NewHexValue("2BB183AF"),
+		},
+		"empty": {
+			value:       NewHexValue(""),
+			expectError: true,
+		},
+		"odd-length": {
+			value:       NewHexValue("2bb"),
+			expectError: true,
+		},
+		"not-hex": {
+			value:       NewHexValue("2bg1"),
+			expectError: true,
+		},
+		"whitespace": {
+			value:       NewHexValue("2bb1 83af"),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.value.ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("test")}, &resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error %t, got %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
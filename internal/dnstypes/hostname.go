@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/idn"
+)
+
+var (
+	_ basetypes.StringTypable                    = (*HostnameType)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*Hostname)(nil)
+	_ xattr.ValidateableAttribute                = (*Hostname)(nil)
+)
+
+// HostnameType is a string type holding a domain name that records point to,
+// such as a CNAME target or an MX exchange.
+type HostnameType struct {
+	basetypes.StringType
+}
+
+func (t HostnameType) String() string {
+	return "dnstypes.HostnameType"
+}
+
+func (t HostnameType) ValueType(ctx context.Context) attr.Value {
+	return Hostname{}
+}
+
+func (t HostnameType) Equal(o attr.Type) bool {
+	other, ok := o.(HostnameType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t HostnameType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Hostname{StringValue: in}, nil
+}
+
+func (t HostnameType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// Hostname is a domain name. Two hostnames are semantically equal when their
+// canonical forms match, that is, regardless of case, of the trailing dot and
+// of whether internationalized labels are given in their Unicode or ASCII form.
+type Hostname struct {
+	basetypes.StringValue
+}
+
+func (v Hostname) Type(ctx context.Context) attr.Type {
+	return HostnameType{}
+}
+
+func (v Hostname) Equal(o attr.Value) bool {
+	other, ok := o.(Hostname)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v Hostname) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Hostname)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return canonicalHostname(v.ValueString()) == canonicalHostname(newValue.ValueString()), diags
+}
+
+func (v Hostname) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	value := v.ValueString()
+
+	if strings.TrimSpace(value) != value {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Hostname String Value",
+			"A string value was provided that contains whitespace.\n\n"+
+				"Given Value: "+value,
+		)
+
+		return
+	}
+
+	ascii, err := idn.ToASCII(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Hostname String Value",
+			"A string value was provided that is not a valid internationalized domain name: "+err.Error()+"\n\n"+
+				"Given Value: "+value,
+		)
+
+		return
+	}
+
+	if _, ok := dns.IsDomainName(ascii); !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Hostname String Value",
+			"A string value was provided that is not a valid domain name.\n\n"+
+				"Given Value: "+value,
+		)
+	}
+}
+
+// NewHostnameNull creates a Hostname with a null value.
+func NewHostnameNull() Hostname {
+	return Hostname{StringValue: basetypes.NewStringNull()}
+}
+
+// NewHostnameUnknown creates a Hostname with an unknown value.
+func NewHostnameUnknown() Hostname {
+	return Hostname{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewHostnameValue creates a Hostname with a known value.
+func NewHostnameValue(value string) Hostname {
+	return Hostname{StringValue: basetypes.NewStringValue(value)}
+}
+
+// canonicalHostname returns the canonical ASCII form of name.
+func canonicalHostname(name string) string {
+	if ascii, err := idn.ToASCII(name); err == nil {
+		name = ascii
+	}
+
+	return dns.CanonicalName(name)
+}
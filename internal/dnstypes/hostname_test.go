@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestHostnameStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentValue  Hostname
+		givenValue    basetypes.StringValuable
+		expectedMatch bool
+	}{
+		"equal": {
+			currentValue:  NewHostnameValue("www.example.com."),
+			givenValue:    NewHostnameValue("www.example.com."),
+			expectedMatch: true,
+		},
+		"case-insensitive": {
+			currentValue:  NewHostnameValue("WWW.Example.COM."),
+			givenValue:    NewHostnameValue("www.example.com."),
+			expectedMatch: true,
+		},
+		"trailing-dot": {
+			currentValue:  NewHostnameValue("www.example.com"),
+			givenValue:    NewHostnameValue("www.example.com."),
+			expectedMatch: true,
+		},
+		"root": {
+			currentValue:  NewHostnameValue("."),
+			givenValue:    NewHostnameValue("."),
+			expectedMatch: true,
+		},
+		"unicode-a-label": {
+			currentValue:  NewHostnameValue("Bücher.example."),
+			givenValue:    NewHostnameValue("xn--bcher-kva.example."),
+			expectedMatch: true,
+		},
+		"different": {
+			currentValue:  NewHostnameValue("www.example.com."),
+			givenValue:    NewHostnameValue("www.example.org."),
+			expectedMatch: false,
+		},
+		"subdomain": {
+			currentValue:  NewHostnameValue("www.example.com."),
+			givenValue:    NewHostnameValue("example.com."),
+			expectedMatch: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentValue.StringSemanticEquals(context.Background(), testCase.givenValue)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if match != testCase.expectedMatch {
+				t.Errorf("expected match %t, got %t", testCase.expectedMatch, match)
+			}
+		})
+	}
+}
+
+func TestHostnameValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       Hostname
+		expectError bool
+	}{
+		"null": {
+			value: NewHostnameNull(),
+		},
+		"unknown": {
+			value: NewHostnameUnknown(),
+		},
+		"fqdn": {
+			value: NewHostnameValue("www.example.com."),
+		},
+		"without-trailing-dot": {
+			value: NewHostnameValue("www.example.com"),
+		},
+		"root": {
+			value: NewHostnameValue("."),
+		},
+		"unicode": {
+			value: NewHostnameValue("bücher.example."),
+		},
+		"invalid-idn": {
+			value:       NewHostnameValue("aש.example."),
+			expectError: true,
+		},
+		"empty": {
+			value:       NewHostnameValue(""),
+			expectError: true,
+		},
+		"whitespace": {
+			value:       NewHostnameValue(" www.example.com."),
+			expectError: true,
+		},
+		"empty-label": {
+			value:       NewHostnameValue("www..example.com."),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.value.ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("test")}, &resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error %t, got %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
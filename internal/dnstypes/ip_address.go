@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = (*IPAddressType)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*IPAddress)(nil)
+	_ xattr.ValidateableAttribute                = (*IPAddress)(nil)
+)
+
+// IPAddressType is a string type holding an IPv4 or IPv6 address.
+type IPAddressType struct {
+	basetypes.StringType
+}
+
+func (t IPAddressType) String() string {
+	return "dnstypes.IPAddressType"
+}
+
+func (t IPAddressType) ValueType(ctx context.Context) attr.Value {
+	return IPAddress{}
+}
+
+func (t IPAddressType) Equal(o attr.Type) bool {
+	other, ok := o.(IPAddressType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t IPAddressType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return IPAddress{StringValue: in}, nil
+}
+
+func (t IPAddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// IPAddress is an IPv4 or IPv6 address. Two addresses are semantically equal
+// when they denote the same address, regardless of their textual form.
+type IPAddress struct {
+	basetypes.StringValue
+}
+
+func (v IPAddress) Type(ctx context.Context) attr.Type {
+	return IPAddressType{}
+}
+
+func (v IPAddress) Equal(o attr.Value) bool {
+	other, ok := o.(IPAddress)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v IPAddress) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IPAddress)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	oldIP := ParseIP(v.ValueString())
+	newIP := ParseIP(newValue.ValueString())
+	if oldIP == nil || newIP == nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+
+	return oldIP.Equal(newIP), diags
+}
+
+func (v IPAddress) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if ParseIP(v.ValueString()) == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address String Value",
+			"A string value was provided that is not a valid IP address.\n\n"+
+				"Given Value: "+v.ValueString(),
+		)
+	}
+}
+
+// ValueIP returns the address as a net.IP, or nil if it is null, unknown or
+// not a valid address.
+func (v IPAddress) ValueIP() net.IP {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	return ParseIP(v.ValueString())
+}
+
+// NewIPAddressNull creates an IPAddress with a null value.
+func NewIPAddressNull() IPAddress {
+	return IPAddress{StringValue: basetypes.NewStringNull()}
+}
+
+// NewIPAddressUnknown creates an IPAddress with an unknown value.
+func NewIPAddressUnknown() IPAddress {
+	return IPAddress{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewIPAddressValue creates an IPAddress with a known value.
+func NewIPAddressValue(value string) IPAddress {
+	return IPAddress{StringValue: basetypes.NewStringValue(value)}
+}
+
+// ParseIP parses an IPv4 or IPv6 address like net.ParseIP, but also accepts
+// IPv4 addresses with leading zeros as they were accepted before Go 1.17.
+func ParseIP(addr string) net.IP {
+	if ip := net.ParseIP(addr); ip != nil {
+		return ip
+	}
+
+	return net.ParseIP(stripLeadingZeros(addr))
+}
+
+func stripLeadingZeros(input string) string {
+	if strings.Contains(input, ".") {
+		classes := strings.Split(input, ".")
+		if len(classes) != 4 {
+			return input
+		}
+		for classIndex, class := range classes {
+			if len(class) <= 1 {
+				continue
+			}
+			classes[classIndex] = strings.TrimLeft(class, "0")
+			if classes[classIndex] == "" {
+				classes[classIndex] = "0"
+			}
+		}
+		return strings.Join(classes, ".")
+	}
+
+	return input
+}
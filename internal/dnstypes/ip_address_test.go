@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestIPAddressStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentValue  IPAddress
+		givenValue    basetypes.StringValuable
+		expectedMatch bool
+	}{
+		"ipv4-equal": {
+			currentValue:  NewIPAddressValue("192.168.0.1"),
+			givenValue:    NewIPAddressValue("192.168.0.1"),
+			expectedMatch: true,
+		},
+		"ipv4-leading-zeros": {
+			currentValue:  NewIPAddressValue("192.168.000.001"),
+			givenValue:    NewIPAddressValue("192.168.0.1"),
+			expectedMatch: true,
+		},
+		"ipv4-different": {
+			currentValue:  NewIPAddressValue("192.168.0.1"),
+			givenValue:    NewIPAddressValue("192.168.0.2"),
+			expectedMatch: false,
+		},
+		"ipv6-compressed": {
+			currentValue:  NewIPAddressValue("FDD5:E282:0000:0000:1234:5678:CAFE:9012"),
+			givenValue:    NewIPAddressValue("fdd5:e282::1234:5678:cafe:9012"),
+			expectedMatch: true,
+		},
+		"ipv6-different": {
+			currentValue:  NewIPAddressValue("fdd5:e282::1"),
+			givenValue:    NewIPAddressValue("fdd5:e282::2"),
+			expectedMatch: false,
+		},
+		"invalid-equal": {
+			currentValue:  NewIPAddressValue("not.an.ip.address"),
+			givenValue:    NewIPAddressValue("not.an.ip.address"),
+			expectedMatch: true,
+		},
+		"invalid-different": {
+			currentValue:  NewIPAddressValue("not.an.ip.address"),
+			givenValue:    NewIPAddressValue("192.168.0.1"),
+			expectedMatch: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentValue.StringSemanticEquals(context.Background(), testCase.givenValue)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if match != testCase.expectedMatch {
+				t.Errorf("expected match %t, got %t", testCase.expectedMatch, match)
+			}
+		})
+	}
+}
+
+func TestIPAddressValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       IPAddress
+		expectError bool
+	}{
+		"null": {
+			value: NewIPAddressNull(),
+		},
+		"unknown": {
+			value: NewIPAddressUnknown(),
+		},
+		"ipv4": {
+			value: NewIPAddressValue("192.168.0.1"),
+		},
+		"ipv4-leading-zeros": {
+			value: NewIPAddressValue("192.168.000.001"),
+		},
+		"ipv6": {
+			value: NewIPAddressValue("fdd5:e282::1"),
+		},
+		"invalid": {
+			value:       NewIPAddressValue("not.an.ip.address"),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.value.ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("test")}, &resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error %t, got %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestStripLeadingZeros(t *testing.T) {
+	testCases := map[string]struct {
+		input    string
+		expected string
+	}{
+		"single-zero": {
+			input:    "192.168.0.1",
+			expected: "192.168.0.1",
+		},
+		"double-zero": {
+			input:    "192.168.00.1",
+			expected: "192.168.0.1",
+		},
+		"triple-zero": {
+			input:    "192.168.000.1",
+			expected: "192.168.0.1",
+		},
+		"leading-zero": {
+			input:    "192.168.010.1",
+			expected: "192.168.10.1",
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := stripLeadingZeros(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package idn converts internationalized domain names between their Unicode
+// form and the ASCII form used on the wire.
+package idn
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+// profile applies the IDNA2008 lookup rules without restricting ASCII labels
+// to letters, digits and hyphens, as DNS names commonly contain underscores
+// and wildcards.
+var profile = idna.New(
+	idna.MapForLookup(),
+	idna.Transitional(false),
+	idna.StrictDomainName(false),
+	idna.BidiRule(),
+)
+
+// ToASCII converts a domain name to its ASCII form, encoding any label that
+// contains non-ASCII characters as an A-label. Names that are already ASCII
+// are returned unchanged, so escaped and pre-encoded names pass through as
+// given. A trailing dot is preserved.
+func ToASCII(name string) (string, error) {
+	if isASCII(name) {
+		return name, nil
+	}
+
+	return convert(name, profile.ToASCII)
+}
+
+// ToUnicode converts a domain name to its Unicode form, decoding any A-label.
+// A trailing dot is preserved.
+func ToUnicode(name string) (string, error) {
+	if !strings.Contains(strings.ToLower(name), "xn--") {
+		return name, nil
+	}
+
+	return convert(name, profile.ToUnicode)
+}
+
+func convert(name string, fn func(string) (string, error)) (string, error) {
+	if name == "." {
+		return name, nil
+	}
+
+	trimmed := strings.TrimSuffix(name, ".")
+	converted, err := fn(trimmed)
+	if err != nil {
+		return name, err
+	}
+
+	if trimmed != name {
+		converted += "."
+	}
+
+	return converted, nil
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+
+	return true
+}
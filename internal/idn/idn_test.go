@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package idn
+
+import (
+	"testing"
+)
+
+func TestToASCII(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input       string
+		expected    string
+		expectError bool
+	}{
+		"ascii": {
+			input:    "www.example.com.",
+			expected: "www.example.com.",
+		},
+		"ascii-case-preserved": {
+			input:    "WWW.Example.com",
+			expected: "WWW.Example.com",
+		},
+		"ascii-underscore": {
+			input:    "_sip._tcp.example.com.",
+			expected: "_sip._tcp.example.com.",
+		},
+		"a-label": {
+			input:    "xn--bcher-kva.example.",
+			expected: "xn--bcher-kva.example.",
+		},
+		"unicode": {
+			input:    "bücher.example.",
+			expected: "xn--bcher-kva.example.",
+		},
+		"unicode-without-trailing-dot": {
+			input:    "bücher.example",
+			expected: "xn--bcher-kva.example",
+		},
+		"unicode-mapped": {
+			input:    "Bücher.example.",
+			expected: "xn--bcher-kva.example.",
+		},
+		"unicode-eszett": {
+			input:    "straße.de.",
+			expected: "xn--strae-oqa.de.",
+		},
+		"unicode-wildcard": {
+			input:    "*.bücher.example.",
+			expected: "*.xn--bcher-kva.example.",
+		},
+		"unicode-label": {
+			input:    "bücher",
+			expected: "xn--bcher-kva",
+		},
+		"invalid-leading-combining-mark": {
+			input:       "̈bucher.example.",
+			expectError: true,
+		},
+		"invalid-bidi": {
+			input:       "aש.example.",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ToASCII(testCase.input)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatalf("expected error, got %q", got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestToUnicode(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected string
+	}{
+		"ascii": {
+			input:    "www.example.com.",
+			expected: "www.example.com.",
+		},
+		"a-label": {
+			input:    "xn--bcher-kva.example.",
+			expected: "bücher.example.",
+		},
+		"a-label-without-trailing-dot": {
+			input:    "xn--bcher-kva.example",
+			expected: "bücher.example",
+		},
+		"root": {
+			input:    ".",
+			expected: ".",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ToUnicode(testCase.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/bodgit/tsig"
@@ -32,6 +33,8 @@ type Config struct {
 	password  string
 	keytab    string
 	recursive bool
+
+	cnameConflicts string
 }
 
 type DNSClient struct {
@@ -48,6 +51,43 @@ type DNSClient struct {
 	password  string
 	keytab    string
 	recursive bool
+	zones     *zoneCache
+
+	cnameConflicts string
+}
+
+// zoneCache remembers the zone discovered for a name so the SOA walk is only
+// done once per name for the lifetime of the provider.
+type zoneCache struct {
+	mu    sync.Mutex
+	zones map[string]string
+}
+
+func newZoneCache() *zoneCache {
+	return &zoneCache{zones: make(map[string]string)}
+}
+
+func (z *zoneCache) get(name string) (string, bool) {
+	if z == nil {
+		return "", false
+	}
+
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	zone, ok := z.zones[dns.CanonicalName(name)]
+	return zone, ok
+}
+
+func (z *zoneCache) set(name, zone string) {
+	if z == nil {
+		return
+	}
+
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	z.zones[dns.CanonicalName(name)] = zone
 }
 
 // Client configures and returns a fully initialized DNSClient.
@@ -78,6 +118,8 @@ func (c *Config) Client(ctx context.Context) (interface{}, error) {
 	client.password = c.password
 	client.keytab = c.keytab
 	client.recursive = c.recursive
+	client.zones = newZoneCache()
+	client.cnameConflicts = c.cnameConflicts
 	if !c.gssapi && c.keyname != "" {
 		if !dns.IsFqdn(c.keyname) {
 			return nil, fmt.Errorf("Error configuring provider: \"key_name\" should be fully-qualified")
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/miekg/dns"
 )
 
 var (
@@ -31,7 +32,7 @@ func (d *dnsARecordSetDataSource) Metadata(ctx context.Context, req datasource.M
 func (d *dnsARecordSetDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Use this data source to get DNS A records of the host.",
-		Attributes: map[string]schema.Attribute{
+		Attributes: dnssecLookupAttributes(map[string]schema.Attribute{
 			"host": schema.StringAttribute{
 				Required:    true,
 				Description: "Host to look up.",
@@ -45,7 +46,7 @@ func (d *dnsARecordSetDataSource) Schema(ctx context.Context, req datasource.Sch
 				Computed:    true,
 				Description: "Always set to the host.",
 			},
-		},
+		}),
 	}
 }
 
@@ -58,7 +59,7 @@ func (d *dnsARecordSetDataSource) Read(ctx context.Context, req datasource.ReadR
 	}
 
 	host := config.Host.ValueString()
-	a, _, err := lookupIP(host)
+	a, err := config.lookupIP(ctx, host, dns.TypeA)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("error looking up A records for %q: ", host), err.Error())
 		return
@@ -80,4 +81,6 @@ type aRecordSetConfig struct {
 	ID    types.String `tfsdk:"id"`
 	Host  types.String `tfsdk:"host"`
 	Addrs types.List   `tfsdk:"addrs"`
+
+	dnssecLookupConfig
 }
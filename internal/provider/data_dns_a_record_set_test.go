@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -30,3 +31,35 @@ data "dns_a_record_set" "test" {
 		},
 	})
 }
+
+func TestAccDataDnsARecordSet_DNSSEC(t *testing.T) {
+	recordName := "data.dns_a_record_set.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "dns_a_record_set" "test" {
+  host   = "isc.org"
+  dnssec = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(recordName, "addrs.0"),
+					resource.TestCheckResourceAttr(recordName, "dnssec_status", "secure"),
+				),
+			},
+			{
+				Config: `
+data "dns_a_record_set" "test" {
+  host          = "isc.org"
+  dnssec        = true
+  trust_anchors = [". IN DS 370 13 2 BE74359954660069D5C63D200C39F5603827D7DD02B56F120EE9F3A86764247C"]
+}
+`,
+				ExpectError: regexp.MustCompile("DNSSEC validation failed"),
+			},
+		},
+	})
+}
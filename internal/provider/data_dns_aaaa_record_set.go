@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/miekg/dns"
 )
 
 var (
@@ -31,7 +32,7 @@ func (d *dnsAAAARecordSetDataSource) Metadata(ctx context.Context, req datasourc
 func (d *dnsAAAARecordSetDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Use this data source to get DNS AAAA records of the host.",
-		Attributes: map[string]schema.Attribute{
+		Attributes: dnssecLookupAttributes(map[string]schema.Attribute{
 			"host": schema.StringAttribute{
 				Required:    true,
 				Description: "Host to look up.",
@@ -45,7 +46,7 @@ func (d *dnsAAAARecordSetDataSource) Schema(ctx context.Context, req datasource.
 				Computed:    true,
 				Description: "Always set to the host.",
 			},
-		},
+		}),
 	}
 }
 
@@ -58,7 +59,7 @@ func (d *dnsAAAARecordSetDataSource) Read(ctx context.Context, req datasource.Re
 	}
 
 	host := config.Host.ValueString()
-	_, aaaa, err := lookupIP(host)
+	aaaa, err := config.lookupIP(ctx, host, dns.TypeAAAA)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("error looking up AAAA records for %q: ", host), err.Error())
 		return
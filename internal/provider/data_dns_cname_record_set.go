@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -30,7 +29,7 @@ func (d *dnsCNAMERecordSetDataSource) Metadata(ctx context.Context, req datasour
 func (d *dnsCNAMERecordSetDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Use this data source to get DNS CNAME record set of the host.",
-		Attributes: map[string]schema.Attribute{
+		Attributes: dnssecLookupAttributes(map[string]schema.Attribute{
 			"host": schema.StringAttribute{
 				Required:    true,
 				Description: "Host to look up.",
@@ -43,7 +42,7 @@ func (d *dnsCNAMERecordSetDataSource) Schema(ctx context.Context, req datasource
 				Computed:    true,
 				Description: "Always set to the host.",
 			},
-		},
+		}),
 	}
 }
 
@@ -56,7 +55,7 @@ func (d *dnsCNAMERecordSetDataSource) Read(ctx context.Context, req datasource.R
 	}
 
 	host := config.Host.ValueString()
-	cname, err := net.LookupCNAME(host)
+	cname, err := config.lookupCNAME(ctx, host)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("error looking up CNAME records for %q: ", host), err.Error())
 		return
@@ -71,4 +70,6 @@ type cnameRecordSetConfig struct {
 	ID    types.String `tfsdk:"id"`
 	Host  types.String `tfsdk:"host"`
 	CNAME types.String `tfsdk:"cname"`
+
+	dnssecLookupConfig
 }
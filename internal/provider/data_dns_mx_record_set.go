@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net"
 	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -14,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/dnstypes"
 )
 
 var (
@@ -33,7 +34,7 @@ func (d *dnsMXRecordSetDataSource) Metadata(ctx context.Context, req datasource.
 func (d *dnsMXRecordSetDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Use this data source to get DNS MX records for a domain.",
-		Attributes: map[string]schema.Attribute{
+		Attributes: dnssecLookupAttributes(map[string]schema.Attribute{
 			"domain": schema.StringAttribute{
 				Required:    true,
 				Description: "Domain to look up.",
@@ -43,7 +44,7 @@ func (d *dnsMXRecordSetDataSource) Schema(ctx context.Context, req datasource.Sc
 				ElementType: types.ObjectType{
 					AttrTypes: map[string]attr.Type{
 						"preference": types.Int64Type,
-						"exchange":   types.StringType,
+						"exchange":   dnstypes.HostnameType{},
 					},
 				},
 				Description: "A list of records. They are sorted by ascending preference then alphabetically by " +
@@ -53,7 +54,7 @@ func (d *dnsMXRecordSetDataSource) Schema(ctx context.Context, req datasource.Sc
 				Computed:    true,
 				Description: "Always set to the domain.",
 			},
-		},
+		}),
 	}
 }
 
@@ -66,7 +67,7 @@ func (d *dnsMXRecordSetDataSource) Read(ctx context.Context, req datasource.Read
 	}
 
 	domain := config.Domain.ValueString()
-	records, err := net.LookupMX(domain)
+	records, err := config.lookupMX(ctx, domain)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("error looking up MX records for %q: ", domain), err.Error())
 		return
@@ -87,7 +88,7 @@ func (d *dnsMXRecordSetDataSource) Read(ctx context.Context, req datasource.Read
 	for i, record := range records {
 		mx[i] = mxBlockConfig{
 			Preference: types.Int64Value(int64(record.Pref)),
-			Exchange:   types.StringValue(record.Host),
+			Exchange:   dnstypes.NewHostnameValue(record.Host),
 		}
 	}
 
@@ -106,9 +107,11 @@ type mxRecordSetConfig struct {
 	ID     types.String `tfsdk:"id"`
 	Domain types.String `tfsdk:"domain"`
 	MX     types.List   `tfsdk:"mx"` //mxBlockConfig
+
+	dnssecLookupConfig
 }
 
 type mxBlockConfig struct {
-	Preference types.Int64  `tfsdk:"preference"`
-	Exchange   types.String `tfsdk:"exchange"`
+	Preference types.Int64       `tfsdk:"preference"`
+	Exchange   dnstypes.Hostname `tfsdk:"exchange"`
 }
@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net"
 	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -32,7 +31,7 @@ func (d *dnsNSRecordSetDataSource) Metadata(ctx context.Context, req datasource.
 func (d *dnsNSRecordSetDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Use this data source to get DNS NS records of the host.",
-		Attributes: map[string]schema.Attribute{
+		Attributes: dnssecLookupAttributes(map[string]schema.Attribute{
 			"host": schema.StringAttribute{
 				Required:    true,
 				Description: "Host to look up.",
@@ -46,7 +45,7 @@ func (d *dnsNSRecordSetDataSource) Schema(ctx context.Context, req datasource.Sc
 				Computed:    true,
 				Description: "Always set to the domain.",
 			},
-		},
+		}),
 	}
 }
 
@@ -59,7 +58,7 @@ func (d *dnsNSRecordSetDataSource) Read(ctx context.Context, req datasource.Read
 	}
 
 	host := config.Host.ValueString()
-	nsRecords, err := net.LookupNS(host)
+	nsRecords, err := config.lookupNS(ctx, host)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("error looking up NS records for %q: ", host), err.Error())
 		return
@@ -86,4 +85,6 @@ type nsRecordSetConfig struct {
 	ID          types.String `tfsdk:"id"`
 	Host        types.String `tfsdk:"host"`
 	Nameservers types.List   `tfsdk:"nameservers"`
+
+	dnssecLookupConfig
 }
@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -30,7 +29,7 @@ func (d *dnsPTRRecordSetDataSource) Metadata(ctx context.Context, req datasource
 func (d *dnsPTRRecordSetDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Use this data source to get DNS PTR record set of the ip address.",
-		Attributes: map[string]schema.Attribute{
+		Attributes: dnssecLookupAttributes(map[string]schema.Attribute{
 			"ip_address": schema.StringAttribute{
 				Required:    true,
 				Description: "IP address to look up.",
@@ -43,7 +42,7 @@ func (d *dnsPTRRecordSetDataSource) Schema(ctx context.Context, req datasource.S
 				Computed:    true,
 				Description: "Always set to the IP address.",
 			},
-		},
+		}),
 	}
 }
 
@@ -56,7 +55,7 @@ func (d *dnsPTRRecordSetDataSource) Read(ctx context.Context, req datasource.Rea
 	}
 
 	ipAddress := config.IPAddress.ValueString()
-	names, err := net.LookupAddr(ipAddress)
+	names, err := config.lookupAddr(ctx, ipAddress)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("error looking up PTR records for %q: ", names), err.Error())
 		return
@@ -76,4 +75,6 @@ type ptrRecordSetConfig struct {
 	ID        types.String `tfsdk:"id"`
 	IPAddress types.String `tfsdk:"ip_address"`
 	PTR       types.String `tfsdk:"ptr"`
+
+	dnssecLookupConfig
 }
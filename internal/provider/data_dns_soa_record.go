@@ -0,0 +1,243 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/idn"
+)
+
+var (
+	_ datasource.DataSource = (*dnsSOARecordDataSource)(nil)
+)
+
+func NewDnsSOARecordDataSource() datasource.DataSource {
+	return &dnsSOARecordDataSource{}
+}
+
+type dnsSOARecordDataSource struct{}
+
+func (d *dnsSOARecordDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_soa_record"
+}
+
+func (d *dnsSOARecordDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to get the DNS SOA record of a zone.",
+		Attributes: dnssecLookupAttributes(map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required:    true,
+				Description: "Zone to look up.",
+			},
+			"query_nameservers": schema.BoolAttribute{
+				Optional: true,
+				Description: "Query the SOA record from each nameserver of the NS records of the zone, without " +
+					"recursion, to return its serial number in `nameserver_serials`. The NS records and the addresses of " +
+					"the nameservers are looked up from `resolver`. Defaults to `false`.",
+			},
+			"mname": schema.StringAttribute{
+				Computed:    true,
+				Description: "The primary nameserver of the zone.",
+			},
+			"rname": schema.StringAttribute{
+				Computed:    true,
+				Description: "The mailbox of the person responsible for the zone, with the `@` replaced by a dot.",
+			},
+			"serial": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The serial number of the zone.",
+			},
+			"refresh": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The interval in seconds before secondary nameservers check the zone for changes.",
+			},
+			"retry": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The interval in seconds before secondary nameservers retry a failed refresh.",
+			},
+			"expire": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The time in seconds after which secondary nameservers stop answering for the zone if it cannot be refreshed.",
+			},
+			"minimum": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The TTL in seconds of negative answers from the zone.",
+			},
+			"nameserver_serials": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Computed:    true,
+				Description: "The serial number of the zone on each nameserver, keyed by its name, when " +
+					"`query_nameservers` is enabled. A serial lower than `serial` shows a stale secondary. The " +
+					"addresses of a nameserver are queried in turn until one answers authoritatively, and a " +
+					"nameserver which does not answer is left out with a warning. These queries are not validated " +
+					"with DNSSEC.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Always set to the zone.",
+			},
+		}),
+	}
+}
+
+func (d *dnsSOARecordDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config soaRecordConfig
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone := config.Zone.ValueString()
+	soa, err := config.lookupSOA(ctx, zone)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("error looking up SOA record for %q: ", zone), err.Error())
+		return
+	}
+
+	config.Mname = types.StringValue(soa.Ns)
+	config.Rname = types.StringValue(soa.Mbox)
+	config.Serial = types.Int64Value(int64(soa.Serial))
+	config.Refresh = types.Int64Value(int64(soa.Refresh))
+	config.Retry = types.Int64Value(int64(soa.Retry))
+	config.Expire = types.Int64Value(int64(soa.Expire))
+	config.Minimum = types.Int64Value(int64(soa.Minttl))
+
+	config.NameserverSerials = types.MapNull(types.Int64Type)
+	if config.QueryNameservers.ValueBool() {
+		serials, diags := nameserverSerials(ctx, zone, config.Resolver.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var convertDiags diag.Diagnostics
+		config.NameserverSerials, convertDiags = types.MapValueFrom(ctx, types.Int64Type, serials)
+		resp.Diagnostics.Append(convertDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	config.ID = config.Zone
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
+
+// nameserverSerials returns the serial number of zone on each nameserver of
+// its NS records, with a warning for each nameserver which does not answer.
+// The NS records and the addresses of the nameservers are looked up from
+// resolver.
+func nameserverSerials(ctx context.Context, zone, resolver string) (map[string]int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ascii, err := idn.ToASCII(dns.Fqdn(zone))
+	if err != nil {
+		diags.AddError(fmt.Sprintf("invalid internationalized domain name %q: ", zone), err.Error())
+		return nil, diags
+	}
+
+	exchange, err := resolverExchange(ctx, resolver)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("error looking up NS records for %q: ", zone), err.Error())
+		return nil, diags
+	}
+
+	records, err := resolverLookup(exchange, ascii, dns.TypeNS)
+	if err == nil && len(records) == 0 {
+		err = fmt.Errorf("no NS records found")
+	}
+	if err != nil {
+		diags.AddError(fmt.Sprintf("error looking up NS records for %q: ", zone), err.Error())
+		return nil, diags
+	}
+
+	nameservers := make([]string, 0, len(records))
+	for _, record := range records {
+		nameservers = append(nameservers, record.(*dns.NS).Ns)
+	}
+	sort.Strings(nameservers)
+
+	serials := make(map[string]int64, len(nameservers))
+	for _, nameserver := range nameservers {
+		addrs, err := nameserverAddrs(exchange, nameserver)
+		if err != nil {
+			diags.AddWarning(fmt.Sprintf("error looking up addresses of nameserver %q: ", nameserver), err.Error())
+			continue
+		}
+
+		for _, addr := range addrs {
+			var serial uint32
+			serial, err = querySerial(ctx, ascii, net.JoinHostPort(addr, "53"))
+			if err == nil {
+				serials[nameserver] = int64(serial)
+				break
+			}
+		}
+		if err != nil {
+			diags.AddWarning(fmt.Sprintf("error querying SOA record from nameserver %q: ", nameserver), err.Error())
+		}
+	}
+
+	return serials, diags
+}
+
+// nameserverAddrs returns the addresses of the A and AAAA records of
+// nameserver, looked up with exchange.
+func nameserverAddrs(exchange func(msg *dns.Msg) (*dns.Msg, error), nameserver string) ([]string, error) {
+	var addrs []string
+	var errs []error
+
+	for _, rrType := range []uint16{dns.TypeA, dns.TypeAAAA} {
+		records, err := resolverLookup(exchange, nameserver, rrType)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		for _, record := range records {
+			switch record := record.(type) {
+			case *dns.A:
+				addrs = append(addrs, record.A.String())
+			case *dns.AAAA:
+				addrs = append(addrs, record.AAAA.String())
+			}
+		}
+	}
+
+	if len(addrs) == 0 {
+		if len(errs) > 0 {
+			return nil, errors.Join(errs...)
+		}
+		return nil, fmt.Errorf("no A or AAAA records found")
+	}
+
+	return addrs, nil
+}
+
+type soaRecordConfig struct {
+	ID                types.String `tfsdk:"id"`
+	Zone              types.String `tfsdk:"zone"`
+	QueryNameservers  types.Bool   `tfsdk:"query_nameservers"`
+	Mname             types.String `tfsdk:"mname"`
+	Rname             types.String `tfsdk:"rname"`
+	Serial            types.Int64  `tfsdk:"serial"`
+	Refresh           types.Int64  `tfsdk:"refresh"`
+	Retry             types.Int64  `tfsdk:"retry"`
+	Expire            types.Int64  `tfsdk:"expire"`
+	Minimum           types.Int64  `tfsdk:"minimum"`
+	NameserverSerials types.Map    `tfsdk:"nameserver_serials"`
+
+	dnssecLookupConfig
+}
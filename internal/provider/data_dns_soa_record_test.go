@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/miekg/dns"
+)
+
+func TestSOAAnswer(t *testing.T) {
+	soa, err := dns.NewRR("example.com. 300 IN SOA ns.example.com. hostmaster.example.com. 42 3600 900 604800 300")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		fqdn     string
+		msg      *dns.Msg
+		expected uint32
+		err      bool
+	}{
+		"answer": {
+			fqdn:     "EXAMPLE.com.",
+			msg:      &dns.Msg{Answer: []dns.RR{soa}},
+			expected: 42,
+		},
+		"nodata": {
+			fqdn: "www.example.com.",
+			msg:  &dns.Msg{Ns: []dns.RR{soa}},
+			err:  true,
+		},
+		"nxdomain": {
+			fqdn: "example.com.",
+			msg:  &dns.Msg{MsgHdr: dns.MsgHdr{Rcode: dns.RcodeNameError}},
+			err:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := soaAnswer(testCase.fqdn, testCase.msg)
+			if testCase.err {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got.Serial != testCase.expected {
+				t.Errorf("expected serial %d, got %d", testCase.expected, got.Serial)
+			}
+		})
+	}
+}
+
+func TestNameserverAddrs(t *testing.T) {
+	testCases := map[string]struct {
+		records  []string
+		rcode    int
+		expected []string
+		err      bool
+	}{
+		"dual-stack": {
+			records:  []string{"ns.example.com. 300 IN A 192.0.2.53", "ns.example.com. 300 IN AAAA 2001:db8::53"},
+			expected: []string{"192.0.2.53", "2001:db8::53"},
+		},
+		"cname": {
+			records:  []string{"ns.example.com. 300 IN CNAME host.example.com.", "host.example.com. 300 IN A 192.0.2.53"},
+			expected: []string{"192.0.2.53"},
+		},
+		"nodata": {
+			err: true,
+		},
+		"nxdomain": {
+			rcode: dns.RcodeNameError,
+			err:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// The stub resolver answers each query with the records of its
+			// type
+			exchange := func(msg *dns.Msg) (*dns.Msg, error) {
+				r := new(dns.Msg)
+				r.SetRcode(msg, testCase.rcode)
+				for _, record := range testCase.records {
+					rr, err := dns.NewRR(record)
+					if err != nil {
+						return nil, err
+					}
+					if rr.Header().Rrtype == msg.Question[0].Qtype || rr.Header().Rrtype == dns.TypeCNAME {
+						r.Answer = append(r.Answer, rr)
+					}
+				}
+				return r, nil
+			}
+
+			addrs, err := nameserverAddrs(exchange, "ns.example.com.")
+			if testCase.err {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(testCase.expected, addrs); diff != "" {
+				t.Errorf("unexpected addresses (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAccDataDnsSOARecord_Basic(t *testing.T) {
+	recordName := "data.dns_soa_record.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "dns_soa_record" "test" {
+  zone              = "ns.dns.tfacc.hashicorptest.com"
+  query_nameservers = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(recordName, "id", "ns.dns.tfacc.hashicorptest.com"),
+					resource.TestMatchResourceAttr(recordName, "mname", regexp.MustCompile(`\.awsdns-\d+\.`)),
+					resource.TestCheckResourceAttr(recordName, "rname", "awsdns-hostmaster.amazon.com."),
+					resource.TestCheckResourceAttrSet(recordName, "serial"),
+					resource.TestCheckResourceAttrSet(recordName, "refresh"),
+					resource.TestCheckResourceAttrSet(recordName, "retry"),
+					resource.TestCheckResourceAttrSet(recordName, "expire"),
+					resource.TestCheckResourceAttrSet(recordName, "minimum"),
+					resource.TestCheckResourceAttr(recordName, "nameserver_serials.%", "4"),
+					resource.TestCheckResourceAttrPair(recordName, "nameserver_serials.ns-67.awsdns-08.com.", recordName, "serial"),
+				),
+			},
+		},
+	})
+}
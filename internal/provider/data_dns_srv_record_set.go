@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net"
 	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -14,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/dnstypes"
 )
 
 var (
@@ -33,7 +34,7 @@ func (d *dnsSRVRecordSetDataSource) Metadata(ctx context.Context, req datasource
 func (d *dnsSRVRecordSetDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Use this data source to get DNS SRV records for a service.",
-		Attributes: map[string]schema.Attribute{
+		Attributes: dnssecLookupAttributes(map[string]schema.Attribute{
 			"service": schema.StringAttribute{
 				Required:    true,
 				Description: "Service to look up.",
@@ -45,7 +46,7 @@ func (d *dnsSRVRecordSetDataSource) Schema(ctx context.Context, req datasource.S
 						"priority": types.Int64Type,
 						"weight":   types.Int64Type,
 						"port":     types.Int64Type,
-						"target":   types.StringType,
+						"target":   dnstypes.HostnameType{},
 					},
 				},
 				Description: "A list of records. They are sorted to stay consistent across runs.",
@@ -54,7 +55,7 @@ func (d *dnsSRVRecordSetDataSource) Schema(ctx context.Context, req datasource.S
 				Computed:    true,
 				Description: "Always set to the service.",
 			},
-		},
+		}),
 	}
 }
 
@@ -67,7 +68,7 @@ func (d *dnsSRVRecordSetDataSource) Read(ctx context.Context, req datasource.Rea
 	}
 
 	service := config.Service.ValueString()
-	_, records, err := net.LookupSRV("", "", service)
+	records, err := config.lookupSRV(ctx, service)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("error looking up SRV records for %q: ", service), err.Error())
 		return
@@ -103,7 +104,7 @@ func (d *dnsSRVRecordSetDataSource) Read(ctx context.Context, req datasource.Rea
 			Priority: types.Int64Value(int64(record.Priority)),
 			Weight:   types.Int64Value(int64(record.Weight)),
 			Port:     types.Int64Value(int64(record.Port)),
-			Target:   types.StringValue(record.Target),
+			Target:   dnstypes.NewHostnameValue(record.Target),
 		}
 	}
 
@@ -122,11 +123,13 @@ type srvRecordSetConfig struct {
 	ID      types.String `tfsdk:"id"`
 	Service types.String `tfsdk:"service"`
 	SRV     types.List   `tfsdk:"srv"` //srvBlockConfig
+
+	dnssecLookupConfig
 }
 
 type srvBlockConfig struct {
-	Priority types.Int64  `tfsdk:"priority"`
-	Weight   types.Int64  `tfsdk:"weight"`
-	Port     types.Int64  `tfsdk:"port"`
-	Target   types.String `tfsdk:"target"`
+	Priority types.Int64       `tfsdk:"priority"`
+	Weight   types.Int64       `tfsdk:"weight"`
+	Port     types.Int64       `tfsdk:"port"`
+	Target   dnstypes.Hostname `tfsdk:"target"`
 }
@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -31,7 +30,7 @@ func (d *dnsTXTRecordSetDataSource) Metadata(ctx context.Context, req datasource
 func (d *dnsTXTRecordSetDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Use this data source to get DNS TXT record set of the host.",
-		Attributes: map[string]schema.Attribute{
+		Attributes: dnssecLookupAttributes(map[string]schema.Attribute{
 			"host": schema.StringAttribute{
 				Required:    true,
 				Description: "Host to look up.",
@@ -49,7 +48,7 @@ func (d *dnsTXTRecordSetDataSource) Schema(ctx context.Context, req datasource.S
 				Computed:    true,
 				Description: "Always set to the host.",
 			},
-		},
+		}),
 	}
 }
 
@@ -62,7 +61,7 @@ func (d *dnsTXTRecordSetDataSource) Read(ctx context.Context, req datasource.Rea
 	}
 
 	host := config.Host.ValueString()
-	records, err := net.LookupTXT(host)
+	records, err := config.lookupTXT(ctx, host)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("error looking up TXT records for %q: ", host), err.Error())
 		return
@@ -90,4 +89,6 @@ type txtRecordSetConfig struct {
 	Host    types.String `tfsdk:"host"`
 	Record  types.String `tfsdk:"record"`
 	Records types.List   `tfsdk:"records"`
+
+	dnssecLookupConfig
 }
@@ -0,0 +1,233 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/idn"
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
+	"github.com/hashicorp/terraform-provider-dns/internal/validators/dnsvalidator"
+)
+
+var (
+	_ datasource.DataSource              = (*dnsZoneExportDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*dnsZoneExportDataSource)(nil)
+)
+
+func NewDnsZoneExportDataSource() datasource.DataSource {
+	return &dnsZoneExportDataSource{}
+}
+
+type dnsZoneExportDataSource struct {
+	client *DNSClient
+}
+
+func (d *dnsZoneExportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_export"
+}
+
+func (d *dnsZoneExportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to export a zone, which is transferred with AXFR from the DNS server of " +
+			"the provider, as a zone file in the RFC 1035 master file format. The transfer is signed with the " +
+			"`update` credentials of the provider, so the server must allow zone transfers for them.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					dnsvalidator.IsZoneNameValid(),
+				},
+				Description: "DNS zone to export. It must be an FQDN, that is, include the trailing dot.",
+			},
+			"content": schema.StringAttribute{
+				Computed: true,
+				Description: "The zone file. It starts with an `$ORIGIN` directive for the zone, followed by the SOA " +
+					"record and the other records in the canonical order of RFC 4034, so the same records always " +
+					"give the same content. Owner names are relative to the zone, and each record has an explicit " +
+					"TTL and class.",
+			},
+			"serial": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The serial number of the SOA record of the zone.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Always set to the zone.",
+			},
+		},
+	}
+}
+
+func (d *dnsZoneExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*DNSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *DNSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *dnsZoneExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config zoneExportConfig
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone := config.Zone.ValueString()
+
+	records, err := transfer(zone, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error transferring DNS zone:", err.Error())
+		return
+	}
+
+	content, serial, err := zoneExportContent(zone, records)
+	if err != nil {
+		resp.Diagnostics.AddError("Error exporting DNS zone:", err.Error())
+		return
+	}
+
+	config.Content = types.StringValue(content)
+	config.Serial = types.Int64Value(int64(serial))
+	config.ID = config.Zone
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
+
+// zoneExportContent renders the records of zone as a zone file and returns
+// it with the serial number of the SOA record. The records are deduplicated,
+// as a zone transfer ends with the SOA record again, and sorted canonically.
+func zoneExportContent(zone string, records []dns.RR) (string, uint32, error) {
+	ascii, err := idn.ToASCII(zone)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid internationalized domain name %q: %w", zone, err)
+	}
+	apex := dns.CanonicalName(ascii)
+
+	var soa *dns.SOA
+	var unique []dns.RR
+	seen := make(map[string]bool, len(records))
+
+	for _, record := range records {
+		if !dns.IsSubDomain(apex, dns.CanonicalName(record.Header().Name)) {
+			return "", 0, fmt.Errorf("record %s is outside of the zone %s", record.Header().Name, zone)
+		}
+
+		// Records differing only in their TTL or the case of their name are
+		// duplicates
+		normalized := dns.Copy(record)
+		normalized.Header().Name = dns.CanonicalName(normalized.Header().Name)
+		normalized.Header().Ttl = 0
+		key := normalized.String()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		if r, ok := record.(*dns.SOA); ok {
+			if soa != nil {
+				return "", 0, fmt.Errorf("zone %s has more than one SOA record", zone)
+			}
+			soa = r
+		}
+
+		unique = append(unique, record)
+	}
+
+	if soa == nil || dns.CanonicalName(soa.Hdr.Name) != apex {
+		return "", 0, fmt.Errorf("zone %s has no SOA record at its apex", zone)
+	}
+
+	sort.SliceStable(unique, func(i, j int) bool {
+		return zoneExportLess(unique[i], unique[j])
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s\n", apex)
+
+	for _, record := range unique {
+		header := record.Header()
+
+		name := "@"
+		if owner := dns.CanonicalName(header.Name); owner != apex {
+			labels := dns.SplitDomainName(owner)
+			name = strings.Join(labels[:len(labels)-dns.CountLabel(apex)], ".")
+		}
+
+		fmt.Fprintf(&b, "%s\t%d\t%s\t%s\t%s\n", name, header.Ttl, dns.Class(header.Class).String(),
+			dns.Type(header.Rrtype).String(), rdata.Text(record))
+	}
+
+	return b.String(), soa.Serial, nil
+}
+
+// zoneExportLess reports whether record a sorts before record b in a zone
+// file, that is, the SOA record comes first and the other records are in the
+// canonical order of their owner names, then by type and by rdata.
+func zoneExportLess(a, b dns.RR) bool {
+	if a.Header().Rrtype == dns.TypeSOA || b.Header().Rrtype == dns.TypeSOA {
+		return a.Header().Rrtype == dns.TypeSOA && b.Header().Rrtype != dns.TypeSOA
+	}
+
+	if cmp := canonicalNameCompare(a.Header().Name, b.Header().Name); cmp != 0 {
+		return cmp < 0
+	}
+
+	if a.Header().Rrtype != b.Header().Rrtype {
+		return a.Header().Rrtype < b.Header().Rrtype
+	}
+
+	return rdata.Text(a) < rdata.Text(b)
+}
+
+// canonicalNameCompare compares the names a and b in the canonical order of
+// RFC 4034 section 6.1, comparing their lowercase labels from the rightmost
+// one, and returns -1, 0 or 1.
+func canonicalNameCompare(a, b string) int {
+	labelsA := dns.SplitDomainName(dns.CanonicalName(a))
+	labelsB := dns.SplitDomainName(dns.CanonicalName(b))
+
+	for i := 1; i <= len(labelsA) && i <= len(labelsB); i++ {
+		if cmp := strings.Compare(labelsA[len(labelsA)-i], labelsB[len(labelsB)-i]); cmp != 0 {
+			return cmp
+		}
+	}
+
+	switch {
+	case len(labelsA) < len(labelsB):
+		return -1
+	case len(labelsA) > len(labelsB):
+		return 1
+	default:
+		return 0
+	}
+}
+
+type zoneExportConfig struct {
+	ID      types.String `tfsdk:"id"`
+	Zone    types.String `tfsdk:"zone"`
+	Content types.String `tfsdk:"content"`
+	Serial  types.Int64  `tfsdk:"serial"`
+}
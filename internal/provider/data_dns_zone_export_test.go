@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/miekg/dns"
+)
+
+func TestZoneExportContent(t *testing.T) {
+	records, err := readZoneFile(strings.NewReader(`$ORIGIN example.com.
+@ 300 IN SOA ns.example.com. hostmaster.example.com. 42 3600 900 604800 300
+www 300 IN A 192.0.2.80
+WWW 300 IN AAAA 2001:db8::80
+b.www 60 IN TXT "b"
+a 300 IN MX 10 www.example.com.
+@ 3600 IN NS ns
+ns 300 IN A 192.0.2.53
+www 300 IN A 192.0.2.10
+*.dev 300 IN CNAME www
+@ 0 IN TYPE65534 \# 5 0d2a3c0001
+@ 300 IN SOA ns.example.com. hostmaster.example.com. 42 3600 900 604800 300
+`), "example.com.", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content, serial, err := zoneExportContent("example.com.", records)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `$ORIGIN example.com.
+@	300	IN	SOA	ns.example.com. hostmaster.example.com. 42 3600 900 604800 300
+@	3600	IN	NS	ns.example.com.
+@	0	IN	TYPE65534	\# 5 0d2a3c0001
+a	300	IN	MX	10 www.example.com.
+*.dev	300	IN	CNAME	www.example.com.
+ns	300	IN	A	192.0.2.53
+www	300	IN	A	192.0.2.10
+www	300	IN	A	192.0.2.80
+www	300	IN	AAAA	2001:db8::80
+b.www	60	IN	TXT	"b"
+`
+	if diff := cmp.Diff(expected, content); diff != "" {
+		t.Errorf("unexpected content (-want +got):\n%s", diff)
+	}
+
+	if serial != 42 {
+		t.Errorf("expected serial 42, got %d", serial)
+	}
+
+	// The content is parsed back into the same records
+	reimported, err := readZoneFile(strings.NewReader(content), "example.com.", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recontent, _, err := zoneExportContent("example.com.", reimported)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(content, recontent); diff != "" {
+		t.Errorf("unexpected content after reimport (-want +got):\n%s", diff)
+	}
+}
+
+func TestZoneExportContent_Errors(t *testing.T) {
+	testCases := map[string]string{
+		"no-soa":       "www.example.com. 300 IN A 192.0.2.80",
+		"outside-zone": "www.example.net. 300 IN A 192.0.2.80",
+	}
+
+	for name, record := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rr, err := dns.NewRR(record)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if _, _, err := zoneExportContent("example.com.", []dns.RR{rr}); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestCanonicalNameCompare(t *testing.T) {
+	// The names are in the canonical order of RFC 4034 section 6.1
+	names := []string{
+		"example.",
+		"a.example.",
+		"yljkjljk.a.example.",
+		"Z.a.example.",
+		"zABC.a.EXAMPLE.",
+		"z.example.",
+		"*.z.example.",
+	}
+
+	for i := range names {
+		for j := range names {
+			expected := 0
+			switch {
+			case i < j:
+				expected = -1
+			case i > j:
+				expected = 1
+			}
+
+			if cmp := canonicalNameCompare(names[i], names[j]); cmp != expected {
+				t.Errorf("expected %q compared to %q to be %d, got %d", names[i], names[j], expected, cmp)
+			}
+		}
+	}
+}
+
+func TestAccDataDnsZoneExport_Basic(t *testing.T) {
+	recordName := "data.dns_zone_export.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDnsARecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataDnsZoneExport_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(recordName, "id", "example.com."),
+					resource.TestCheckResourceAttrSet(recordName, "serial"),
+					resource.TestMatchResourceAttr(recordName, "content", regexp.MustCompile(`^\$ORIGIN example\.com\.\n@\t\d+\tIN\tSOA\t`)),
+					resource.TestMatchResourceAttr(recordName, "content", regexp.MustCompile(`\nexport\t300\tIN\tA\t192\.168\.0\.1\n`)),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataDnsZoneExport_basic = `
+resource "dns_a_record_set" "export" {
+  zone      = "example.com."
+  name      = "export"
+  addresses = ["192.168.0.1"]
+  ttl       = 300
+}
+
+data "dns_zone_export" "test" {
+  zone = dns_a_record_set.export.zone
+}
+`
@@ -0,0 +1,248 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/idn"
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
+	"github.com/hashicorp/terraform-provider-dns/internal/validators/dnsvalidator"
+)
+
+var (
+	_ datasource.DataSource = (*dnsZoneFileDataSource)(nil)
+)
+
+func NewDnsZoneFileDataSource() datasource.DataSource {
+	return &dnsZoneFileDataSource{}
+}
+
+type dnsZoneFileDataSource struct{}
+
+func (d *dnsZoneFileDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_file"
+}
+
+func (d *dnsZoneFileDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to parse a zone file in the RFC 1035 master file format, including the " +
+			"`$ORIGIN`, `$TTL`, `$INCLUDE` and `$GENERATE` directives. The records are grouped by name and type, " +
+			"so they can be used with `for_each` in the record set resources.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					dnsvalidator.IsZoneNameValid(),
+				},
+				Description: "The zone of the zone file, which is the initial `$ORIGIN`. It must be an FQDN, that " +
+					"is, include the trailing dot. Every record must be within the zone.",
+			},
+			"path": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("content")),
+				},
+				Description: "The path of the zone file. Relative `$INCLUDE` directives are resolved from its " +
+					"directory. Exactly one of `path` or `content` must be set.",
+			},
+			"content": schema.StringAttribute{
+				Optional: true,
+				Description: "The content of the zone file. Relative `$INCLUDE` directives are resolved from the " +
+					"working directory.",
+			},
+			"record_sets": schema.MapAttribute{
+				Computed:    true,
+				ElementType: zoneFileRecordSetConfig{}.objectType(),
+				Description: "The record sets of the zone file, keyed by their fully qualified domain name and type " +
+					"separated by a space, such as `www.example.com. A`. Each record set has the `name` relative to the " +
+					"zone, which is empty at the apex, the `fqdn`, the `type`, the lowest `ttl` of the records, the " +
+					"`rdata` of each record in presentation format, such as `10 mail.example.com.`, sorted " +
+					"alphabetically, and the `fields` of each record in the same order. The fields are named as in the " +
+					"`rdata` returned by the `parse_rr` function, character-strings are concatenated, as in the `txt` " +
+					"attribute of `dns_txt_record_set`, and type bitmaps are separated by spaces.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Always set to the zone.",
+			},
+		},
+	}
+}
+
+func (d *dnsZoneFileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config zoneFileConfig
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone := config.Zone.ValueString()
+	filePath := config.Path.ValueString()
+
+	var r io.Reader
+	if config.Path.IsNull() {
+		r = strings.NewReader(config.Content.ValueString())
+	} else {
+		f, err := os.Open(filePath)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading zone file:", err.Error())
+			return
+		}
+		//nolint:errcheck
+		defer f.Close()
+		r = f
+	}
+
+	records, err := readZoneFile(r, zone, filePath)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing zone file:", err.Error())
+		return
+	}
+
+	sets, err := zoneFileRecordSets(zone, records)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing zone file:", err.Error())
+		return
+	}
+
+	var convertDiags diag.Diagnostics
+	config.RecordSets, convertDiags = types.MapValueFrom(ctx, zoneFileRecordSetConfig{}.objectType(), sets)
+	resp.Diagnostics.Append(convertDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ID = config.Zone
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
+
+// zoneFileRecordSets groups the records of zone by name and type.
+func zoneFileRecordSets(zone string, records []dns.RR) (map[string]zoneFileRecordSetConfig, error) {
+	ascii, err := idn.ToASCII(zone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid internationalized domain name %q: %w", zone, err)
+	}
+
+	grouped := make(map[string][]dns.RR)
+	for _, record := range records {
+		owner := dns.CanonicalName(record.Header().Name)
+		if !dns.IsSubDomain(dns.CanonicalName(ascii), owner) {
+			return nil, fmt.Errorf("record %s is outside of the zone %s", record.Header().Name, zone)
+		}
+
+		config := dnsConfig{Name: zoneRelativeName(zone, ascii, owner), Zone: zone}
+		key := resourceFQDN_framework(config) + " " + dns.Type(record.Header().Rrtype).String()
+		grouped[key] = append(grouped[key], record)
+	}
+
+	sets := make(map[string]zoneFileRecordSetConfig, len(grouped))
+	for key, records := range grouped {
+		sort.Slice(records, func(i, j int) bool {
+			return rdata.Text(records[i]) < rdata.Text(records[j])
+		})
+
+		name := zoneRelativeName(zone, ascii, dns.CanonicalName(records[0].Header().Name))
+		ttl := records[0].Header().Ttl
+
+		values := make([]string, 0, len(records))
+		fields := make([]map[string]string, 0, len(records))
+		for _, record := range records {
+			ttl = min(ttl, record.Header().Ttl)
+			values = append(values, rdata.Text(record))
+
+			f, err := zoneFileFields(record)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, f)
+		}
+
+		sets[key] = zoneFileRecordSetConfig{
+			Name:   types.StringValue(name),
+			FQDN:   types.StringValue(resourceFQDN_framework(dnsConfig{Name: name, Zone: zone})),
+			Type:   types.StringValue(dns.Type(records[0].Header().Rrtype).String()),
+			TTL:    types.Int64Value(int64(ttl)),
+			RData:  values,
+			Fields: fields,
+		}
+	}
+
+	return sets, nil
+}
+
+// zoneFileFields returns the rdata fields of record as strings.
+func zoneFileFields(record dns.RR) (map[string]string, error) {
+	fields, err := rdata.Fields(record)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(fields))
+	for _, field := range fields {
+		switch value := field.Value.(type) {
+		case string:
+			values[field.Name] = value
+		case uint64:
+			values[field.Name] = strconv.FormatUint(value, 10)
+		case []string:
+			sep := ""
+			if field.Name == "type_bit_map" {
+				sep = " "
+			}
+			values[field.Name] = strings.Join(value, sep)
+		}
+	}
+
+	return values, nil
+}
+
+type zoneFileConfig struct {
+	ID         types.String `tfsdk:"id"`
+	Zone       types.String `tfsdk:"zone"`
+	Path       types.String `tfsdk:"path"`
+	Content    types.String `tfsdk:"content"`
+	RecordSets types.Map    `tfsdk:"record_sets"` //zoneFileRecordSetConfig
+}
+
+type zoneFileRecordSetConfig struct {
+	Name   types.String        `tfsdk:"name"`
+	FQDN   types.String        `tfsdk:"fqdn"`
+	Type   types.String        `tfsdk:"type"`
+	TTL    types.Int64         `tfsdk:"ttl"`
+	RData  []string            `tfsdk:"rdata"`
+	Fields []map[string]string `tfsdk:"fields"`
+}
+
+func (m zoneFileRecordSetConfig) objectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: m.objectAttributeTypes()}
+}
+
+func (m zoneFileRecordSetConfig) objectAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":   types.StringType,
+		"fqdn":   types.StringType,
+		"type":   types.StringType,
+		"ttl":    types.Int64Type,
+		"rdata":  types.ListType{ElemType: types.StringType},
+		"fields": types.ListType{ElemType: types.MapType{ElemType: types.StringType}},
+	}
+}
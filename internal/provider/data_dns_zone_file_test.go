@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestZoneFileRecordSets(t *testing.T) {
+	f, err := os.Open("testdata/zone_file/db.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	records, err := readZoneFile(f, "example.com.", "testdata/zone_file/db.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sets, err := zoneFileRecordSets("example.com.", records)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys := make([]string, 0, len(sets))
+	for key := range sets {
+		keys = append(keys, key)
+	}
+
+	expectedKeys := []string{
+		"example.com. SOA",
+		"example.com. NS",
+		"example.com. MX",
+		"example.com. TXT",
+		"example.com. TYPE65534",
+		"example.com. TYPE65280",
+		"ns.example.com. A",
+		"host1.example.com. A",
+		"host2.example.com. A",
+		"www.example.com. A",
+		"_sip._tcp.www.example.com. SRV",
+	}
+	if diff := cmp.Diff(expectedKeys, keys, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("unexpected record sets (-want +got):\n%s", diff)
+	}
+
+	testCases := map[string]zoneFileRecordSetConfig{
+		"example.com. MX": {
+			Name:  types.StringValue(""),
+			FQDN:  types.StringValue("example.com."),
+			Type:  types.StringValue("MX"),
+			TTL:   types.Int64Value(3600),
+			RData: []string{"10 smtp.example.com.", "20 backup.example.com."},
+			Fields: []map[string]string{
+				{"preference": "10", "mx": "smtp.example.com."},
+				{"preference": "20", "mx": "backup.example.com."},
+			},
+		},
+		"example.com. TXT": {
+			Name:   types.StringValue(""),
+			FQDN:   types.StringValue("example.com."),
+			Type:   types.StringValue("TXT"),
+			TTL:    types.Int64Value(3600),
+			RData:  []string{`"v=spf1 mx -all"`},
+			Fields: []map[string]string{{"txt": "v=spf1 mx -all"}},
+		},
+		"example.com. TYPE65534": {
+			Name:   types.StringValue(""),
+			FQDN:   types.StringValue("example.com."),
+			Type:   types.StringValue("TYPE65534"),
+			TTL:    types.Int64Value(3600),
+			RData:  []string{`\# 5 0d2a3c0001`},
+			Fields: []map[string]string{{"rdata": "0d2a3c0001"}},
+		},
+		"host2.example.com. A": {
+			Name:   types.StringValue("host2"),
+			FQDN:   types.StringValue("host2.example.com."),
+			Type:   types.StringValue("A"),
+			TTL:    types.Int64Value(3600),
+			RData:  []string{"192.0.2.2"},
+			Fields: []map[string]string{{"a": "192.0.2.2"}},
+		},
+		"www.example.com. A": {
+			Name:   types.StringValue("www"),
+			FQDN:   types.StringValue("www.example.com."),
+			Type:   types.StringValue("A"),
+			TTL:    types.Int64Value(60),
+			RData:  []string{"192.0.2.80", "192.0.2.81"},
+			Fields: []map[string]string{{"a": "192.0.2.80"}, {"a": "192.0.2.81"}},
+		},
+	}
+
+	for key, expected := range testCases {
+		t.Run(key, func(t *testing.T) {
+			if diff := cmp.Diff(expected, sets[key]); diff != "" {
+				t.Errorf("unexpected record set (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestZoneFileRecordSets_OutOfZone(t *testing.T) {
+	records, err := readZoneFile(strings.NewReader("www.example.net. 300 IN A 192.0.2.1\n"), "example.com.", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := zoneFileRecordSets("example.com.", records); err == nil {
+		t.Fatal("expected an error for a record outside of the zone")
+	}
+}
+
+func TestAccDataDnsZoneFile_Basic(t *testing.T) {
+	recordName := "data.dns_zone_file.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "dns_zone_file" "test" {
+  zone = "example.com."
+  path = "testdata/zone_file/db.example.com"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(recordName, "id", "example.com."),
+					resource.TestCheckResourceAttr(recordName, "record_sets.%", "11"),
+					resource.TestCheckResourceAttr(recordName, "record_sets.www.example.com. A.name", "www"),
+					resource.TestCheckResourceAttr(recordName, "record_sets.www.example.com. A.ttl", "60"),
+					resource.TestCheckResourceAttr(recordName, "record_sets.www.example.com. A.rdata.#", "2"),
+					resource.TestCheckResourceAttr(recordName, "record_sets.example.com. MX.fields.0.mx", "smtp.example.com."),
+				),
+			},
+			{
+				Config: `
+data "dns_zone_file" "test" {
+  zone    = "example.com."
+  content = <<-EOT
+    $ORIGIN example.com.
+    $TTL 300
+    @ IN A 192.0.2.1
+    EOT
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(recordName, "record_sets.%", "1"),
+					resource.TestCheckResourceAttr(recordName, "record_sets.example.com. A.name", ""),
+					resource.TestCheckResourceAttr(recordName, "record_sets.example.com. A.rdata.0", "192.0.2.1"),
+				),
+			},
+		},
+	})
+}
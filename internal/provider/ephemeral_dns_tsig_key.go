@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = (*dnsTSIGKeyEphemeralResource)(nil)
+
+func NewDnsTSIGKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &dnsTSIGKeyEphemeralResource{}
+}
+
+type dnsTSIGKeyEphemeralResource struct{}
+
+func (d *dnsTSIGKeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tsig_key"
+}
+
+func (d *dnsTSIGKeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a TSIG key to sign DNS messages with, as defined in RFC 8945, without storing " +
+			"it in the Terraform plan or state. A new secret is generated on every run, so pass it to " +
+			"write-only arguments or other ephemeral contexts only. Use the `dns_tsig_key` resource for a " +
+			"key which is kept in the state.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the key, such as `tsig-key.`.",
+			},
+			"algorithm": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(tsigKeyAlgorithms...),
+				},
+				Description: "The HMAC algorithm of the key. Valid values are `hmac-md5`, `hmac-sha1`, " +
+					"`hmac-sha256` or `hmac-sha512`. Defaults to `hmac-sha256`.",
+			},
+			"secret": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The Base64-encoded secret of the key, as long as the output of the HMAC algorithm.",
+			},
+			"bind_config": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The `key` statement declaring the key in the BIND configuration.",
+			},
+		},
+	}
+}
+
+func (d *dnsTSIGKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config tsigKeyEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Algorithm.IsNull() {
+		config.Algorithm = types.StringValue("hmac-sha256")
+	}
+
+	secret, bindConfig, err := generateTSIGKey(config.Name.ValueString(), config.Algorithm.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating TSIG key:", err.Error())
+		return
+	}
+
+	config.Secret = types.StringValue(secret)
+	config.BindConfig = types.StringValue(bindConfig)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
+
+type tsigKeyEphemeralResourceModel struct {
+	Name       types.String `tfsdk:"name"`
+	Algorithm  types.String `tfsdk:"algorithm"`
+	Secret     types.String `tfsdk:"secret"`
+	BindConfig types.String `tfsdk:"bind_config"`
+}
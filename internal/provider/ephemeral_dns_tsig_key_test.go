@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccEphemeralDnsTSIGKey_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralDnsTSIGKey_basic,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("algorithm"), knownvalue.StringExact("hmac-sha1")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("secret"), knownvalue.StringRegexp(regexp.MustCompile(`^[A-Za-z0-9+/]{27}=$`))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("bind_config"), knownvalue.StringRegexp(regexp.MustCompile(`^key "tsig-key\." \{\n\talgorithm hmac-sha1;\n`))),
+				},
+			},
+		},
+	})
+}
+
+var testAccEphemeralDnsTSIGKey_basic = `
+  ephemeral "dns_tsig_key" "foo" {
+    name = "tsig-key."
+    algorithm = "hmac-sha1"
+  }
+
+  provider "echo" {
+    data = ephemeral.dns_tsig_key.foo
+  }
+
+  resource "echo" "test" {}`
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/miekg/dns"
+)
+
+var _ function.Function = (*canonicalNameFunction)(nil)
+
+func NewCanonicalNameFunction() function.Function {
+	return &canonicalNameFunction{}
+}
+
+type canonicalNameFunction struct{}
+
+func (f *canonicalNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "canonical_name"
+}
+
+func (f *canonicalNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Returns the canonical form of a domain name",
+		Description: "Returns a domain name in the canonical form defined in RFC 4034 section 6.2, that is, " +
+			"lowercase and fully qualified, so that names can be compared as strings.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The domain name, with or without the trailing dot.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *canonicalNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	if _, ok := dns.IsDomainName(name); !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a valid domain name.", name))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, dns.CanonicalName(name)))
+}
@@ -0,0 +1,198 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
+)
+
+var _ function.Function = (*formatRRFunction)(nil)
+
+func NewFormatRRFunction() function.Function {
+	return &formatRRFunction{}
+}
+
+type formatRRFunction struct{}
+
+func (f *formatRRFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "format_rr"
+}
+
+func (f *formatRRFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Formats a resource record in presentation format",
+		Description: "Renders an object with the `name`, `ttl`, `class`, `type` and `rdata` of a resource " +
+			"record, as returned by `parse_rr`, in the presentation format of zone files. `ttl` defaults to " +
+			"`3600` and `class` to `IN`. Every field of the type must be set in `rdata`, and the rendered " +
+			"record is parsed again to validate it.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:        "record",
+				Description: "The resource record object.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *formatRRFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var record types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &record))
+	if resp.Error != nil {
+		return
+	}
+
+	rr, err := formatRRRecord(record.UnderlyingValue())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	// Records are parsed again, as rdata.SetFields does not check names and
+	// encoded fields
+	parsed, err := dns.NewRR(rr.String())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid record %q: %s", rr.String(), err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, parsed.String()))
+}
+
+// formatRRRecord returns the record described by the attributes of value.
+func formatRRRecord(value attr.Value) (dns.RR, error) {
+	obj, ok := value.(types.Object)
+	if !ok {
+		return nil, fmt.Errorf("Expected an object, got %s.", value.Type(context.Background()))
+	}
+	attrs := obj.Attributes()
+
+	for name := range attrs {
+		switch name {
+		case "name", "ttl", "class", "type", "rdata":
+		default:
+			return nil, fmt.Errorf("Unsupported attribute %q.", name)
+		}
+	}
+
+	name, err := formatRRValue(attrs["name"], "name")
+	if err != nil {
+		return nil, err
+	}
+	rrTypeName, err := formatRRValue(attrs["type"], "type")
+	if err != nil {
+		return nil, err
+	}
+
+	hdr := dns.RR_Header{
+		Name:  name.(string),
+		Class: dns.ClassINET,
+		Ttl:   3600,
+	}
+
+	rrType, ok := dns.StringToType[strings.ToUpper(rrTypeName.(string))]
+	newRR, supported := dns.TypeToRR[rrType]
+	if !ok || !supported {
+		return nil, fmt.Errorf("Unsupported record type %q.", rrTypeName)
+	}
+	hdr.Rrtype = rrType
+
+	if v, ok := attrs["class"]; ok && !v.IsNull() {
+		class, err := formatRRValue(v, "class")
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Class, ok = dns.StringToClass[strings.ToUpper(class.(string))]; !ok {
+			return nil, fmt.Errorf("Unsupported class %q.", class)
+		}
+	}
+
+	if v, ok := attrs["ttl"]; ok && !v.IsNull() {
+		ttl, err := formatRRValue(v, "ttl")
+		if err != nil {
+			return nil, err
+		}
+		n, ok := ttl.(uint64)
+		if !ok || n > 1<<31-1 {
+			return nil, fmt.Errorf("Attribute %q must be an integer between 0 and 2147483647.", "ttl")
+		}
+		hdr.Ttl = uint32(n)
+	}
+
+	rdataValue, ok := attrs["rdata"].(types.Object)
+	if !ok {
+		return nil, fmt.Errorf("Attribute %q must be an object.", "rdata")
+	}
+
+	values := make(map[string]interface{}, len(rdataValue.Attributes()))
+	for name, v := range rdataValue.Attributes() {
+		if values[name], err = formatRRValue(v, "rdata."+name); err != nil {
+			return nil, err
+		}
+	}
+
+	rr := newRR()
+	*rr.Header() = hdr
+	if err := rdata.SetFields(rr, values); err != nil {
+		return nil, fmt.Errorf("Invalid rdata: %s.", err)
+	}
+
+	return rr, nil
+}
+
+// formatRRValue converts an attribute of a record object to the values of
+// rdata.Field.
+func formatRRValue(value attr.Value, name string) (interface{}, error) {
+	if value == nil || value.IsNull() {
+		return nil, fmt.Errorf("Missing attribute %q.", name)
+	}
+
+	switch v := value.(type) {
+	case types.String:
+		return v.ValueString(), nil
+	case types.Number:
+		n, accuracy := v.ValueBigFloat().Uint64()
+		if !v.ValueBigFloat().IsInt() || accuracy != big.Exact {
+			return nil, fmt.Errorf("Attribute %q must be a non-negative integer.", name)
+		}
+		return n, nil
+	case types.Int64:
+		if v.ValueInt64() < 0 {
+			return nil, fmt.Errorf("Attribute %q must be a non-negative integer.", name)
+		}
+		return uint64(v.ValueInt64()), nil
+	case types.List:
+		return formatRRStrings(v.Elements(), name)
+	case types.Tuple:
+		return formatRRStrings(v.Elements(), name)
+	case types.Set:
+		return formatRRStrings(v.Elements(), name)
+	}
+
+	return nil, fmt.Errorf("Unsupported value for attribute %q.", name)
+}
+
+func formatRRStrings(elems []attr.Value, name string) ([]string, error) {
+	values := make([]string, 0, len(elems))
+	for _, elem := range elems {
+		s, ok := elem.(types.String)
+		if !ok || s.IsNull() {
+			return nil, fmt.Errorf("Attribute %q must be a list of strings.", name)
+		}
+		values = append(values, s.ValueString())
+	}
+
+	return values, nil
+}
@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionFormatRR_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+				  value = provider::dns::format_rr({
+				    name  = "example.com."
+				    ttl   = 300
+				    type  = "MX"
+				    rdata = {
+				      preference = 10
+				      mx         = "mail.example.com."
+				    }
+				  })
+				}`,
+				Check: resource.TestCheckOutput("test", "example.com.\t300\tIN\tMX\t10 mail.example.com."),
+			},
+		},
+	})
+}
+
+func TestAccFunctionFormatRR_RoundTrip(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+				  value = provider::dns::format_rr(provider::dns::parse_rr("example.com. 300 IN TXT \"hello world\""))
+				}`,
+				Check: resource.TestCheckOutput("test", "example.com.\t300\tIN\tTXT\t\"hello world\""),
+			},
+		},
+	})
+}
+
+func TestAccFunctionFormatRR_Invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+				  value = provider::dns::format_rr({
+				    name  = "example.com."
+				    type  = "MX"
+				    rdata = {
+				      mx = "mail.example.com."
+				    }
+				  })
+				}`,
+				ExpectError: regexp.MustCompile(`missing rdata field "preference"`),
+			},
+		},
+	})
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/miekg/dns"
+)
+
+var _ function.Function = (*fqdnFunction)(nil)
+
+func NewFQDNFunction() function.Function {
+	return &fqdnFunction{}
+}
+
+type fqdnFunction struct{}
+
+func (f *fqdnFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "fqdn"
+}
+
+func (f *fqdnFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Returns the fully qualified domain name of a record",
+		Description: "Returns the fully qualified domain name of a record from its name and zone, the same way " +
+			"as the `fqdn` attribute of the record resources. An empty name returns the zone itself.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The name of the record, relative to the zone.",
+			},
+			function.StringParameter{
+				Name:        "zone",
+				Description: "The DNS zone of the record. It must be an FQDN, that is, include the trailing dot.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *fqdnFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name, zone string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name, &zone))
+	if resp.Error != nil {
+		return
+	}
+
+	if name != "" && dns.IsFqdn(name) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("The name %q must be relative to the zone, that is, not include the trailing dot.", name))
+		return
+	}
+	if !dns.IsFqdn(zone) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("The zone %q must be an FQDN, that is, include the trailing dot.", zone))
+		return
+	}
+
+	fqdn := resourceFQDN_framework(dnsConfig{
+		Name: name,
+		Zone: zone,
+	})
+
+	if _, ok := dns.IsDomainName(fqdn); !ok {
+		resp.Error = function.NewFuncError(fmt.Sprintf("%q is not a valid domain name.", fqdn))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, fqdn))
+}
@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/miekg/dns"
+)
+
+var _ function.Function = (*isSubdomainFunction)(nil)
+
+func NewIsSubdomainFunction() function.Function {
+	return &isSubdomainFunction{}
+}
+
+type isSubdomainFunction struct{}
+
+func (f *isSubdomainFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "is_subdomain"
+}
+
+func (f *isSubdomainFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Checks whether a domain name is within another",
+		Description: "Returns whether `name` is equal to or below `parent` in the DNS tree, such as " +
+			"`www.example.com.` within `example.com.`. Names are compared label by label and case-insensitively, " +
+			"with or without the trailing dot.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The domain name to check.",
+			},
+			function.StringParameter{
+				Name:        "parent",
+				Description: "The domain name `name` must be within.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *isSubdomainFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name, parent string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name, &parent))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, dns.IsSubDomain(dns.Fqdn(parent), dns.Fqdn(name))))
+}
@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
+)
+
+var _ function.Function = (*parseRRFunction)(nil)
+
+func NewParseRRFunction() function.Function {
+	return &parseRRFunction{}
+}
+
+type parseRRFunction struct{}
+
+func (f *parseRRFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_rr"
+}
+
+func (f *parseRRFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parses a resource record in presentation format",
+		Description: "Parses a single resource record in the presentation format of zone files, such as " +
+			"`example.com. 300 IN MX 10 mail.example.com.`, into an object with its `name`, `ttl`, `class`, " +
+			"`type` and `rdata`. The attributes of `rdata` depend on the type and are named after the fields " +
+			"of the record in snake case, such as `preference` and `mx` for MX records, or `key_tag`, " +
+			"`algorithm`, `digest_type` and `digest` for DS records. Integers are numbers, character strings " +
+			"and type bitmaps are lists of strings, and other fields are strings. Names must be fully " +
+			"qualified, and the TTL defaults to `3600`. Errors include the line and column of the offending " +
+			"token.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "record",
+				Description: "The resource record in presentation format.",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+func (f *parseRRFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var record string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &record))
+	if resp.Error != nil {
+		return
+	}
+
+	rr, err := dns.NewRR(record)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if rr == nil {
+		resp.Error = function.NewArgumentFuncError(0, "No resource record found.")
+		return
+	}
+
+	fields, err := rdata.Fields(rr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	rdataTypes := make(map[string]attr.Type, len(fields))
+	rdataValues := make(map[string]attr.Value, len(fields))
+	for _, field := range fields {
+		switch v := field.Value.(type) {
+		case string:
+			rdataTypes[field.Name] = types.StringType
+			rdataValues[field.Name] = types.StringValue(v)
+		case uint64:
+			rdataTypes[field.Name] = types.NumberType
+			rdataValues[field.Name] = types.NumberValue(new(big.Float).SetUint64(v))
+		case []string:
+			elems := make([]attr.Value, 0, len(v))
+			for _, s := range v {
+				elems = append(elems, types.StringValue(s))
+			}
+			rdataTypes[field.Name] = types.ListType{ElemType: types.StringType}
+			rdataValues[field.Name] = types.ListValueMust(types.StringType, elems)
+		}
+	}
+
+	rdataValue, diags := types.ObjectValue(rdataTypes, rdataValues)
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	hdr := rr.Header()
+	result, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"name":  types.StringType,
+			"ttl":   types.NumberType,
+			"class": types.StringType,
+			"type":  types.StringType,
+			"rdata": rdataValue.Type(ctx),
+		},
+		map[string]attr.Value{
+			"name":  types.StringValue(hdr.Name),
+			"ttl":   types.NumberValue(new(big.Float).SetUint64(uint64(hdr.Ttl))),
+			"class": types.StringValue(dns.Class(hdr.Class).String()),
+			"type":  types.StringValue(dns.Type(hdr.Rrtype).String()),
+			"rdata": rdataValue,
+		},
+	)
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.DynamicValue(result)))
+}
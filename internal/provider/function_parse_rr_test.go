@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFunctionParseRR_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+				  mx = provider::dns::parse_rr("example.com. 300 IN MX 10 mail.example.com.")
+				}
+
+				output "type" {
+				  value = local.mx.type
+				}
+
+				output "ttl" {
+				  value = local.mx.ttl
+				}
+
+				output "mx" {
+				  value = local.mx.rdata.mx
+				}
+
+				output "preference" {
+				  value = local.mx.rdata.preference
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("type", "MX"),
+					resource.TestCheckOutput("ttl", "300"),
+					resource.TestCheckOutput("mx", "mail.example.com."),
+					resource.TestCheckOutput("preference", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFunctionParseRR_Invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+				  value = provider::dns::parse_rr("example.com. IN MX x mail.example.com.")
+				}`,
+				ExpectError: regexp.MustCompile(`bad MX Pref: "x" at line: 1:21`),
+			},
+		},
+	})
+}
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/miekg/dns"
+)
+
+var _ function.Function = (*reverseNameFunction)(nil)
+
+func NewReverseNameFunction() function.Function {
+	return &reverseNameFunction{}
+}
+
+type reverseNameFunction struct{}
+
+func (f *reverseNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "reverse_name"
+}
+
+func (f *reverseNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Returns the reverse DNS name of an IP address",
+		Description: "Returns the name of the PTR record of an IPv4 or IPv6 address, such as " +
+			"`1.2.0.192.in-addr.arpa.` for `192.0.2.1`. IPv6 addresses are expanded to one label per nibble " +
+			"under `ip6.arpa.`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "address",
+				Description: "The IPv4 or IPv6 address.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *reverseNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var address string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &address))
+	if resp.Error != nil {
+		return
+	}
+
+	name, err := dns.ReverseAddr(address)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, name))
+}
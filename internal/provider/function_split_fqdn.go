@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/miekg/dns"
+)
+
+var _ function.Function = (*splitFQDNFunction)(nil)
+
+var splitFQDNAttributeTypes = map[string]attr.Type{
+	"name": types.StringType,
+	"zone": types.StringType,
+}
+
+func NewSplitFQDNFunction() function.Function {
+	return &splitFQDNFunction{}
+}
+
+type splitFQDNFunction struct{}
+
+func (f *splitFQDNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "split_fqdn"
+}
+
+func (f *splitFQDNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Splits a fully qualified domain name into a record name and zone",
+		Description: "Returns an object with the `name` of a record relative to its `zone`, as used by the record " +
+			"resources, from its fully qualified domain name. The name is empty for the apex of the zone. " +
+			"Names are compared case-insensitively.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "fqdn",
+				Description: "The fully qualified domain name of the record, including the trailing dot.",
+			},
+			function.StringParameter{
+				Name:        "zone",
+				Description: "The DNS zone of the record. It must be an FQDN, that is, include the trailing dot.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: splitFQDNAttributeTypes,
+		},
+	}
+}
+
+func (f *splitFQDNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var fqdn, zone string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &fqdn, &zone))
+	if resp.Error != nil {
+		return
+	}
+
+	if !dns.IsFqdn(fqdn) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q must be an FQDN, that is, include the trailing dot.", fqdn))
+		return
+	}
+	if !dns.IsFqdn(zone) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("The zone %q must be an FQDN, that is, include the trailing dot.", zone))
+		return
+	}
+	if !dns.IsSubDomain(zone, fqdn) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not within the zone %q.", fqdn, zone))
+		return
+	}
+
+	// Labels are split on the offsets returned by dns.Split so that escaped
+	// dots are kept within their label
+	var name string
+	if n := dns.CountLabel(fqdn) - dns.CountLabel(zone); n > 0 {
+		name = fqdn[:dns.Split(fqdn)[n]-1]
+	}
+
+	result, diags := types.ObjectValue(splitFQDNAttributeTypes, map[string]attr.Value{
+		"name": types.StringValue(name),
+		"zone": types.StringValue(zone),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
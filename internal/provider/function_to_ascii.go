@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/idn"
+)
+
+var _ function.Function = (*toASCIIFunction)(nil)
+
+func NewToASCIIFunction() function.Function {
+	return &toASCIIFunction{}
+}
+
+type toASCIIFunction struct{}
+
+func (f *toASCIIFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "to_ascii"
+}
+
+func (f *toASCIIFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts an internationalized domain name to its ASCII form",
+		Description: "Converts a domain name to the ASCII form used on the wire, encoding each label which " +
+			"contains non-ASCII characters as an A-label according to IDNA2008, such as `xn--bcher-kva.example.` " +
+			"for `bücher.example.`. ASCII labels, including underscores and wildcards, are returned unchanged.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The domain name, with or without the trailing dot, which is preserved.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *toASCIIFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := idn.ToASCII(name)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
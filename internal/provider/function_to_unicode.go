@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/idn"
+)
+
+var _ function.Function = (*toUnicodeFunction)(nil)
+
+func NewToUnicodeFunction() function.Function {
+	return &toUnicodeFunction{}
+}
+
+type toUnicodeFunction struct{}
+
+func (f *toUnicodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "to_unicode"
+}
+
+func (f *toUnicodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts an internationalized domain name to its Unicode form",
+		Description: "Converts a domain name to its Unicode form, decoding each A-label according to IDNA2008, " +
+			"such as `bücher.example.` for `xn--bcher-kva.example.`. Other labels are returned unchanged.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The domain name, with or without the trailing dot, which is preserved.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *toUnicodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := idn.ToUnicode(name)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
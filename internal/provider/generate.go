@@ -0,0 +1,915 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/miekg/dns"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/idn"
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
+)
+
+// generateResource is a resource the generate subcommand writes the
+// configuration of, along with the function returning the attributes and
+// nested blocks specific to its records.
+type generateResource struct {
+	typeName    string
+	newResource func() resource.Resource
+	body        func(records []dns.RR) (generateBody, error)
+}
+
+// generateBody holds the attributes and nested blocks of a resource, in the
+// order they are written.
+type generateBody struct {
+	attributes []generateAttribute
+	blocks     []generateBlock
+}
+
+type generateAttribute struct {
+	name  string
+	value cty.Value
+}
+
+type generateBlock struct {
+	name string
+	body generateBody
+}
+
+var generateResources = map[uint16]generateResource{
+	dns.TypeA:       {"_a_record_set", NewDnsARecordSetResource, generateAddresses},
+	dns.TypeAAAA:    {"_aaaa_record_set", NewDnsAAAARecordSetResource, generateAddresses},
+	dns.TypeCDNSKEY: {"_cdnskey_record_set", NewDnsCDNSKEYRecordSetResource, generateDNSKEY("cdnskey")},
+	dns.TypeCDS:     {"_cds_record_set", NewDnsCDSRecordSetResource, generateDS("cds")},
+	dns.TypeCNAME:   {"_cname_record", NewDnsCNAMERecordResource, generateCNAME},
+	dns.TypeDNSKEY:  {"_dnskey_record_set", NewDnsDNSKEYRecordSetResource, generateDNSKEY("dnskey")},
+	dns.TypeDS:      {"_ds_record_set", NewDnsDSRecordSetResource, generateDS("ds")},
+	dns.TypeMX:      {"_mx_record_set", NewDnsMXRecordSetResource, generateMX},
+	dns.TypeNS:      {"_ns_record_set", NewDnsNSRecordSetResource, generateNS},
+	dns.TypePTR:     {"_ptr_record", NewDnsPTRRecordResource, generatePTR},
+	dns.TypeSOA:     {"_soa", NewDnsSOAResource, generateSOA},
+	dns.TypeSRV:     {"_srv_record_set", NewDnsSRVRecordSetResource, generateSRV},
+	dns.TypeTXT:     {"_txt_record_set", NewDnsTXTRecordSetResource, generateTXT},
+}
+
+// Generate runs the generate subcommand of the provider binary, which writes
+// the configuration of the resources managing the records of a zone, along
+// with the import blocks adopting them. The records are transferred with
+// AXFR from the DNS server configured with the DNS_UPDATE_* environment
+// variables of the provider, or read from a zone file.
+func Generate(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	zone := flags.String("zone", "", "DNS `zone` to generate the configuration of, including the trailing dot")
+	zoneFile := flags.String("zone-file", "", "read the records from the zone `file` instead of transferring the zone")
+	out := flags.String("out", "", "write the configuration to `file` instead of the standard output")
+
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: terraform-provider-dns generate -zone ZONE [-zone-file FILE] [-out FILE]\n\n"+
+			"Writes the configuration of the dns_* resources and import blocks for the records of a zone.\n"+
+			"The zone is transferred with AXFR from the DNS server configured with the DNS_UPDATE_*\n"+
+			"environment variables of the provider, unless -zone-file is given.\n\n")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	if *zone == "" || flags.NArg() > 0 {
+		flags.Usage()
+		return errors.New("-zone is required and no arguments are accepted")
+	}
+	if !dns.IsFqdn(*zone) {
+		return fmt.Errorf("zone %q must be fully qualified, that is, include the trailing dot", *zone)
+	}
+
+	var records []dns.RR
+	var err error
+	if *zoneFile != "" {
+		records, err = generateReadZoneFile(*zone, *zoneFile)
+	} else {
+		records, err = generateTransfer(ctx, *zone)
+	}
+	if err != nil {
+		return err
+	}
+
+	f, warnings, err := generateConfig(ctx, *zone, records)
+	if err != nil {
+		return err
+	}
+
+	for _, warning := range warnings {
+		fmt.Fprintf(stderr, "Warning: %s\n", warning)
+	}
+
+	if *out != "" {
+		return os.WriteFile(*out, f.Bytes(), 0o644)
+	}
+
+	_, err = f.WriteTo(stdout)
+	return err
+}
+
+// generateTransfer transfers zone with the client of the provider, configured
+// as for an empty provider block, that is, from the DNS_UPDATE_* environment
+// variables.
+func generateTransfer(ctx context.Context, zone string) ([]dns.RR, error) {
+	p := NewFrameworkProvider()
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, &providerModel{
+		CNAMEConflicts: types.StringNull(),
+		Update:         types.ListNull(providerUpdateModel{}.objectType()),
+	})
+	if diags.HasError() {
+		return nil, generateDiagnosticsError(diags)
+	}
+
+	var configureResp provider.ConfigureResponse
+	p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		return nil, generateDiagnosticsError(configureResp.Diagnostics)
+	}
+
+	client, ok := configureResp.ResourceData.(*DNSClient)
+	if !ok {
+		return nil, fmt.Errorf("expected *DNSClient, got: %T", configureResp.ResourceData)
+	}
+
+	records, err := transfer(zone, client)
+	if err != nil {
+		return nil, fmt.Errorf("error transferring DNS zone %s: %w", zone, err)
+	}
+
+	return records, nil
+}
+
+// generateDiagnosticsError returns the error diagnostics as an error.
+func generateDiagnosticsError(diags diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags.Errors() {
+		errs = append(errs, fmt.Errorf("%s %s", d.Summary(), d.Detail()))
+	}
+
+	return errors.Join(errs...)
+}
+
+// generateReadZoneFile returns the records of zone read from the zone file at
+// path.
+func generateReadZoneFile(zone, path string) ([]dns.RR, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	//nolint:errcheck
+	defer f.Close()
+
+	return readZoneFile(f, zone, path)
+}
+
+// generateConfig returns the configuration of the resources and import blocks
+// for the records of zone, along with warnings about the records which were
+// not generated.
+func generateConfig(ctx context.Context, zone string, records []dns.RR) (*hclwrite.File, []string, error) {
+	ascii, err := idn.ToASCII(zone)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid internationalized domain name %q: %w", zone, err)
+	}
+	apex := dns.CanonicalName(ascii)
+	delegations := zoneDelegations(apex, records)
+
+	type recordSet struct {
+		name    string
+		rrType  uint16
+		records []dns.RR
+	}
+
+	sets := make(map[string]*recordSet)
+	var skipped int
+
+	for _, record := range records {
+		if !isAuthoritative(apex, delegations, record) {
+			skipped++
+			continue
+		}
+
+		name := zoneRelativeName(ascii, ascii, dns.CanonicalName(record.Header().Name))
+		key := name + " " + dns.Type(record.Header().Rrtype).String()
+
+		set, ok := sets[key]
+		if !ok {
+			set = &recordSet{name: name, rrType: record.Header().Rrtype}
+			sets[key] = set
+		}
+
+		// The SOA record ends zone transfers, so it is seen twice
+		duplicate := false
+		for _, r := range set.records {
+			if dns.IsDuplicate(r, record) {
+				duplicate = true
+			}
+		}
+		if !duplicate {
+			set.records = append(set.records, record)
+		}
+	}
+
+	keys := make([]string, 0, len(sets))
+	for key := range sets {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var warnings []string
+	if skipped > 0 {
+		warnings = append(warnings, fmt.Sprintf("records outside of the zone or below its delegations are not "+
+			"authoritative, %d were skipped", skipped))
+	}
+
+	// generated is a record set along with the configuration of its resource.
+	type generated struct {
+		set      *recordSet
+		typeName string
+		config   dnsConfig
+		body     generateBody
+	}
+
+	var resources []generated
+	var unsupported []string
+
+	for _, key := range keys {
+		set := sets[key]
+		fqdn := resourceFQDN_framework(dnsConfig{Name: set.name, Zone: ascii})
+
+		res, ok := generateResources[set.rrType]
+		if !ok {
+			unsupported = append(unsupported, fmt.Sprintf("%s %s", fqdn, dns.Type(set.rrType).String()))
+			warnings = append(warnings, fmt.Sprintf("%s %s records are not supported by the provider and were skipped",
+				fqdn, dns.Type(set.rrType).String()))
+			continue
+		}
+
+		sort.Slice(set.records, func(i, j int) bool {
+			return rdata.Text(set.records[i]) < rdata.Text(set.records[j])
+		})
+
+		ttl := set.records[0].Header().Ttl
+		for _, record := range set.records {
+			ttl = min(ttl, record.Header().Ttl)
+		}
+
+		specific, err := res.body(set.records)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("the %s records of %s were skipped: %s", dns.Type(set.rrType).String(), fqdn, err))
+			continue
+		}
+
+		config := dnsConfig{Name: zoneRelativeName(zone, ascii, fqdn), Zone: zone}
+		resourceBody := generateBody{
+			attributes: []generateAttribute{{"zone", cty.StringVal(config.Zone)}},
+		}
+		if config.Name != "" {
+			resourceBody.attributes = append(resourceBody.attributes, generateAttribute{"name", cty.StringVal(config.Name)})
+		}
+		resourceBody.attributes = append(resourceBody.attributes, specific.attributes...)
+		resourceBody.attributes = append(resourceBody.attributes, generateAttribute{"ttl", cty.NumberIntVal(int64(ttl))})
+		resourceBody.blocks = specific.blocks
+
+		r := res.newResource()
+		var schemaResp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+		if err := generateCheck(schemaResp.Schema, resourceBody); err != nil {
+			return nil, nil, fmt.Errorf("generating dns%s: %w", res.typeName, err)
+		}
+
+		// Some record sets cannot be managed by their resource, such as the
+		// NS records at the apex of the zone, which require a name
+		if diags := generateValidate(ctx, r, schemaResp.Schema, resourceBody); diags.HasError() {
+			var details []string
+			for _, d := range diags.Errors() {
+				details = append(details, d.Detail())
+			}
+			unsupported = append(unsupported, fmt.Sprintf("%s %s", fqdn, dns.Type(set.rrType).String()))
+			warnings = append(warnings, fmt.Sprintf("%s %s records are not supported by dns%s and were skipped: %s",
+				fqdn, dns.Type(set.rrType).String(), res.typeName, strings.Join(details, "; ")))
+			continue
+		}
+
+		resources = append(resources, generated{set, "dns" + res.typeName, config, resourceBody})
+	}
+
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+
+	if len(unsupported) > 0 {
+		comments := "# The following record sets are not supported by the provider and were skipped:\n"
+		for _, set := range unsupported {
+			comments += fmt.Sprintf("#   %s\n", set)
+		}
+		body.AppendUnstructuredTokens(hclwrite.Tokens{
+			{Type: hclsyntax.TokenComment, Bytes: []byte(comments)},
+		})
+	}
+
+	labels := make(map[string]bool)
+
+	for _, r := range resources {
+		label := generateLabel(r.set.name)
+		for i := 2; labels[r.typeName+"."+label]; i++ {
+			label = fmt.Sprintf("%s_%d", generateLabel(r.set.name), i)
+		}
+		labels[r.typeName+"."+label] = true
+
+		if len(body.Blocks()) > 0 || len(unsupported) > 0 {
+			body.AppendNewline()
+		}
+
+		block := body.AppendNewBlock("resource", []string{r.typeName, label})
+		generateWriteBody(block.Body(), r.body)
+
+		body.AppendNewline()
+
+		importBlock := body.AppendNewBlock("import", nil)
+		importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: r.typeName},
+			hcl.TraverseAttr{Name: label},
+		})
+		importBlock.Body().SetAttributeValue("id", cty.StringVal(resourceFQDN_framework(r.config)))
+	}
+
+	return f, warnings, nil
+}
+
+// generateCheck returns an error if an attribute or nested block of body is
+// not an argument of the resource schema s.
+func generateCheck(s schema.Schema, body generateBody) error {
+	for _, a := range body.attributes {
+		attribute, ok := s.Attributes[a.name]
+		if !ok || !(attribute.IsRequired() || attribute.IsOptional()) {
+			return fmt.Errorf("%q is not an argument", a.name)
+		}
+	}
+
+	for _, b := range body.blocks {
+		block, ok := s.Blocks[b.name]
+		if !ok {
+			return fmt.Errorf("%q is not a block", b.name)
+		}
+
+		attributes := block.GetNestedObject().GetAttributes()
+		for _, a := range b.body.attributes {
+			attribute, ok := attributes[a.name]
+			if !ok || !(attribute.IsRequired() || attribute.IsOptional()) {
+				return fmt.Errorf("%q is not an argument of block %q", a.name, b.name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// generateValidate returns the diagnostics of the validators of the schema s
+// and of the resource r for the configuration made of body, as Terraform
+// reports them when validating the generated configuration.
+func generateValidate(ctx context.Context, r resource.Resource, s schema.Schema, body generateBody) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	raw, err := generateValue(s.Type().TerraformType(ctx), s.Blocks, body)
+	if err != nil {
+		diags.AddError("Error converting the generated configuration:", err.Error())
+		return diags
+	}
+	config := tfsdk.Config{Schema: s, Raw: raw}
+
+	var values map[string]tftypes.Value
+	if err := raw.As(&values); err != nil {
+		diags.AddError("Error converting the generated configuration:", err.Error())
+		return diags
+	}
+
+	diags.Append(generateValidateAttributes(ctx, config, path.Empty(), s.Attributes, values)...)
+	for name, block := range s.Blocks {
+		diags.Append(generateValidateBlock(ctx, config, path.Root(name), block, values[name])...)
+	}
+
+	if v, ok := r.(resource.ResourceWithConfigValidators); ok {
+		for _, check := range v.ConfigValidators(ctx) {
+			var resp resource.ValidateConfigResponse
+			check.ValidateResource(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+			diags.Append(resp.Diagnostics...)
+		}
+	}
+	if v, ok := r.(resource.ResourceWithValidateConfig); ok {
+		var resp resource.ValidateConfigResponse
+		v.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+		diags.Append(resp.Diagnostics...)
+	}
+
+	return diags
+}
+
+// generateValidateAttributes returns the diagnostics of the validators of the
+// attributes below parent for their values.
+func generateValidateAttributes(ctx context.Context, config tfsdk.Config, parent path.Path, attributes map[string]schema.Attribute, values map[string]tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for name, attribute := range attributes {
+		p := parent.AtName(name)
+
+		value, err := attribute.GetType().ValueFromTerraform(ctx, values[name])
+		if err != nil {
+			diags.AddAttributeError(p, "Error converting the generated configuration:", err.Error())
+			continue
+		}
+
+		switch a := attribute.(type) {
+		case schema.StringAttribute:
+			v, d := value.(basetypes.StringValuable).ToStringValue(ctx)
+			diags.Append(d...)
+			for _, check := range a.Validators {
+				req := validator.StringRequest{Path: p, PathExpression: p.Expression(), Config: config, ConfigValue: v}
+				var resp validator.StringResponse
+				check.ValidateString(ctx, req, &resp)
+				diags.Append(resp.Diagnostics...)
+			}
+		case schema.Int64Attribute:
+			v, d := value.(basetypes.Int64Valuable).ToInt64Value(ctx)
+			diags.Append(d...)
+			for _, check := range a.Validators {
+				req := validator.Int64Request{Path: p, PathExpression: p.Expression(), Config: config, ConfigValue: v}
+				var resp validator.Int64Response
+				check.ValidateInt64(ctx, req, &resp)
+				diags.Append(resp.Diagnostics...)
+			}
+		case schema.BoolAttribute:
+			v, d := value.(basetypes.BoolValuable).ToBoolValue(ctx)
+			diags.Append(d...)
+			for _, check := range a.Validators {
+				req := validator.BoolRequest{Path: p, PathExpression: p.Expression(), Config: config, ConfigValue: v}
+				var resp validator.BoolResponse
+				check.ValidateBool(ctx, req, &resp)
+				diags.Append(resp.Diagnostics...)
+			}
+		case schema.ListAttribute:
+			v, d := value.(basetypes.ListValuable).ToListValue(ctx)
+			diags.Append(d...)
+			for _, check := range a.Validators {
+				req := validator.ListRequest{Path: p, PathExpression: p.Expression(), Config: config, ConfigValue: v}
+				var resp validator.ListResponse
+				check.ValidateList(ctx, req, &resp)
+				diags.Append(resp.Diagnostics...)
+			}
+		case schema.SetAttribute:
+			v, d := value.(basetypes.SetValuable).ToSetValue(ctx)
+			diags.Append(d...)
+			for _, check := range a.Validators {
+				req := validator.SetRequest{Path: p, PathExpression: p.Expression(), Config: config, ConfigValue: v}
+				var resp validator.SetResponse
+				check.ValidateSet(ctx, req, &resp)
+				diags.Append(resp.Diagnostics...)
+			}
+		default:
+			diags.AddAttributeError(p, "Error validating the generated configuration:",
+				fmt.Sprintf("unexpected attribute type %T", attribute))
+		}
+	}
+
+	return diags
+}
+
+// generateValidateBlock returns the diagnostics of the validators of the
+// nested block at p, of its objects and of their attributes for its value.
+func generateValidateBlock(ctx context.Context, config tfsdk.Config, p path.Path, block schema.Block, raw tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	value, err := block.Type().ValueFromTerraform(ctx, raw)
+	if err != nil {
+		diags.AddAttributeError(p, "Error converting the generated configuration:", err.Error())
+		return diags
+	}
+
+	var nested schema.NestedBlockObject
+	var elements []attr.Value
+	var paths []path.Path
+
+	switch b := block.(type) {
+	case schema.SetNestedBlock:
+		v := value.(basetypes.SetValue)
+		for _, check := range b.Validators {
+			req := validator.SetRequest{Path: p, PathExpression: p.Expression(), Config: config, ConfigValue: v}
+			var resp validator.SetResponse
+			check.ValidateSet(ctx, req, &resp)
+			diags.Append(resp.Diagnostics...)
+		}
+		nested = b.NestedObject
+		elements = v.Elements()
+		for _, element := range elements {
+			paths = append(paths, p.AtSetValue(element))
+		}
+	case schema.ListNestedBlock:
+		v := value.(basetypes.ListValue)
+		for _, check := range b.Validators {
+			req := validator.ListRequest{Path: p, PathExpression: p.Expression(), Config: config, ConfigValue: v}
+			var resp validator.ListResponse
+			check.ValidateList(ctx, req, &resp)
+			diags.Append(resp.Diagnostics...)
+		}
+		nested = b.NestedObject
+		elements = v.Elements()
+		for i := range elements {
+			paths = append(paths, p.AtListIndex(i))
+		}
+	default:
+		diags.AddAttributeError(p, "Error validating the generated configuration:",
+			fmt.Sprintf("unexpected block type %T", block))
+		return diags
+	}
+
+	var rawElements []tftypes.Value
+	if err := raw.As(&rawElements); err != nil {
+		diags.AddAttributeError(p, "Error converting the generated configuration:", err.Error())
+		return diags
+	}
+
+	for i, element := range elements {
+		object := element.(basetypes.ObjectValue)
+		for _, check := range nested.Validators {
+			req := validator.ObjectRequest{Path: paths[i], PathExpression: paths[i].Expression(), Config: config, ConfigValue: object}
+			var resp validator.ObjectResponse
+			check.ValidateObject(ctx, req, &resp)
+			diags.Append(resp.Diagnostics...)
+		}
+
+		var values map[string]tftypes.Value
+		if err := rawElements[i].As(&values); err != nil {
+			diags.AddAttributeError(paths[i], "Error converting the generated configuration:", err.Error())
+			continue
+		}
+		diags.Append(generateValidateAttributes(ctx, config, paths[i], nested.Attributes, values)...)
+	}
+
+	return diags
+}
+
+// generateValue returns the Terraform value of type t for body, whose nested
+// blocks are described by blocks. The attributes body does not set are null,
+// and the nested blocks it does not set are empty.
+func generateValue(t tftypes.Type, blocks map[string]schema.Block, body generateBody) (tftypes.Value, error) {
+	object, ok := t.(tftypes.Object)
+	if !ok {
+		return tftypes.Value{}, fmt.Errorf("expected an object type, got %s", t)
+	}
+
+	values := make(map[string]tftypes.Value, len(object.AttributeTypes))
+	for name, attributeType := range object.AttributeTypes {
+		if _, ok := blocks[name]; ok {
+			values[name] = tftypes.NewValue(attributeType, []tftypes.Value{})
+		} else {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+
+	for _, a := range body.attributes {
+		v, err := generateCtyValue(object.AttributeTypes[a.name], a.value)
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("%s: %w", a.name, err)
+		}
+		values[a.name] = v
+	}
+
+	elements := make(map[string][]tftypes.Value)
+	for _, b := range body.blocks {
+		var elementType tftypes.Type
+		switch blockType := object.AttributeTypes[b.name].(type) {
+		case tftypes.List:
+			elementType = blockType.ElementType
+		case tftypes.Set:
+			elementType = blockType.ElementType
+		default:
+			return tftypes.Value{}, fmt.Errorf("%s: unexpected block type %s", b.name, blockType)
+		}
+
+		v, err := generateValue(elementType, nil, b.body)
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("%s: %w", b.name, err)
+		}
+		elements[b.name] = append(elements[b.name], v)
+	}
+	for name, e := range elements {
+		values[name] = tftypes.NewValue(object.AttributeTypes[name], e)
+	}
+
+	return tftypes.NewValue(t, values), nil
+}
+
+// generateCtyValue returns the Terraform value of type t for v.
+func generateCtyValue(t tftypes.Type, v cty.Value) (tftypes.Value, error) {
+	switch {
+	case v.IsNull():
+		return tftypes.NewValue(t, nil), nil
+	case t.Equal(tftypes.String):
+		return tftypes.NewValue(t, v.AsString()), nil
+	case t.Equal(tftypes.Number):
+		return tftypes.NewValue(t, v.AsBigFloat()), nil
+	case t.Equal(tftypes.Bool):
+		return tftypes.NewValue(t, v.True()), nil
+	}
+
+	var elementType tftypes.Type
+	switch collectionType := t.(type) {
+	case tftypes.List:
+		elementType = collectionType.ElementType
+	case tftypes.Set:
+		elementType = collectionType.ElementType
+	default:
+		return tftypes.Value{}, fmt.Errorf("unexpected type %s", t)
+	}
+
+	var elements []tftypes.Value
+	for it := v.ElementIterator(); it.Next(); {
+		_, element := it.Element()
+		e, err := generateCtyValue(elementType, element)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		elements = append(elements, e)
+	}
+
+	return tftypes.NewValue(t, elements), nil
+}
+
+func generateWriteBody(body *hclwrite.Body, b generateBody) {
+	for _, a := range b.attributes {
+		body.SetAttributeValue(a.name, a.value)
+	}
+
+	for _, nested := range b.blocks {
+		body.AppendNewline()
+		generateWriteBody(body.AppendNewBlock(nested.name, nil).Body(), nested.body)
+	}
+}
+
+// generateLabel returns a resource name for the record set at name, made of
+// lowercase letters, digits, underscores and dashes.
+func generateLabel(name string) string {
+	if name == "" {
+		return "apex"
+	}
+
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r == '*':
+			b.WriteString("wildcard")
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_', r == '-':
+			b.WriteRune(r)
+		default:
+			b.WriteByte('_')
+		}
+	}
+
+	label := b.String()
+	if c := label[0]; (c >= '0' && c <= '9') || c == '-' {
+		label = "_" + label
+	}
+
+	return label
+}
+
+func generateAddresses(records []dns.RR) (generateBody, error) {
+	var addresses []cty.Value
+	for _, record := range records {
+		var addr string
+		var err error
+		if record.Header().Rrtype == dns.TypeA {
+			addr, _, err = rdata.A(record)
+		} else {
+			addr, _, err = rdata.AAAA(record)
+		}
+		if err != nil {
+			return generateBody{}, err
+		}
+		addresses = append(addresses, cty.StringVal(addr))
+	}
+
+	return generateBody{
+		attributes: []generateAttribute{{"addresses", cty.ListVal(addresses)}},
+	}, nil
+}
+
+func generateCNAME(records []dns.RR) (generateBody, error) {
+	if len(records) != 1 {
+		return generateBody{}, fmt.Errorf("expected a single record, got %d", len(records))
+	}
+
+	cname, _, err := rdata.CNAME(records[0])
+	if err != nil {
+		return generateBody{}, err
+	}
+
+	return generateBody{
+		attributes: []generateAttribute{{"cname", cty.StringVal(cname)}},
+	}, nil
+}
+
+func generatePTR(records []dns.RR) (generateBody, error) {
+	if len(records) != 1 {
+		return generateBody{}, fmt.Errorf("expected a single record, got %d", len(records))
+	}
+
+	ptr, _, err := rdata.PTR(records[0])
+	if err != nil {
+		return generateBody{}, err
+	}
+
+	return generateBody{
+		attributes: []generateAttribute{{"ptr", cty.StringVal(ptr)}},
+	}, nil
+}
+
+func generateNS(records []dns.RR) (generateBody, error) {
+	var nameservers []cty.Value
+	for _, record := range records {
+		nameserver, _, err := rdata.NS(record)
+		if err != nil {
+			return generateBody{}, err
+		}
+		nameservers = append(nameservers, cty.StringVal(nameserver))
+	}
+
+	return generateBody{
+		attributes: []generateAttribute{{"nameservers", cty.ListVal(nameservers)}},
+	}, nil
+}
+
+func generateMX(records []dns.RR) (generateBody, error) {
+	var body generateBody
+	for _, record := range records {
+		preference, exchange, _, err := rdata.MX(record)
+		if err != nil {
+			return generateBody{}, err
+		}
+		body.blocks = append(body.blocks, generateBlock{"mx", generateBody{
+			attributes: []generateAttribute{
+				{"preference", cty.NumberIntVal(preference)},
+				{"exchange", cty.StringVal(exchange)},
+			},
+		}})
+	}
+
+	return body, nil
+}
+
+func generateSRV(records []dns.RR) (generateBody, error) {
+	var body generateBody
+	for _, record := range records {
+		priority, weight, port, target, _, err := rdata.SRV(record)
+		if err != nil {
+			return generateBody{}, err
+		}
+		body.blocks = append(body.blocks, generateBlock{"srv", generateBody{
+			attributes: []generateAttribute{
+				{"priority", cty.NumberIntVal(priority)},
+				{"weight", cty.NumberIntVal(weight)},
+				{"target", cty.StringVal(target)},
+				{"port", cty.NumberIntVal(port)},
+			},
+		}})
+	}
+
+	return body, nil
+}
+
+// generateTXT sets txt, unless a record is not split into character-strings
+// the way the provider splits long values, in which case chunks is set.
+func generateTXT(records []dns.RR) (generateBody, error) {
+	var txt, chunks []cty.Value
+	split := true
+	for _, record := range records {
+		c, _, err := rdata.TXT(record)
+		if err != nil {
+			return generateBody{}, err
+		}
+
+		value := strings.Join(c, "")
+		if !slices.Equal(c, rdata.SplitTXT(value)) {
+			split = false
+		}
+
+		strs := make([]cty.Value, 0, len(c))
+		for _, s := range c {
+			strs = append(strs, cty.StringVal(s))
+		}
+
+		txt = append(txt, cty.StringVal(value))
+		chunks = append(chunks, cty.ListVal(strs))
+	}
+
+	if !split {
+		return generateBody{
+			attributes: []generateAttribute{{"chunks", cty.ListVal(chunks)}},
+		}, nil
+	}
+
+	return generateBody{
+		attributes: []generateAttribute{{"txt", cty.ListVal(txt)}},
+	}, nil
+}
+
+func generateDS(name string) func([]dns.RR) (generateBody, error) {
+	return func(records []dns.RR) (generateBody, error) {
+		var body generateBody
+		for _, record := range records {
+			keyTag, algorithm, digestType, digest, _, err := rdata.DS(record)
+			if err != nil {
+				return generateBody{}, err
+			}
+			body.blocks = append(body.blocks, generateBlock{name, generateBody{
+				attributes: []generateAttribute{
+					{"key_tag", cty.NumberIntVal(keyTag)},
+					{"algorithm", cty.NumberIntVal(algorithm)},
+					{"digest_type", cty.NumberIntVal(digestType)},
+					{"digest", cty.StringVal(digest)},
+				},
+			}})
+		}
+
+		return body, nil
+	}
+}
+
+func generateDNSKEY(name string) func([]dns.RR) (generateBody, error) {
+	return func(records []dns.RR) (generateBody, error) {
+		var body generateBody
+		for _, record := range records {
+			flags, algorithm, publicKey, _, err := rdata.DNSKEY(record)
+			if err != nil {
+				return generateBody{}, err
+			}
+			body.blocks = append(body.blocks, generateBlock{name, generateBody{
+				attributes: []generateAttribute{
+					{"flags", cty.NumberIntVal(flags)},
+					{"algorithm", cty.NumberIntVal(algorithm)},
+					{"public_key", cty.StringVal(publicKey)},
+				},
+			}})
+		}
+
+		return body, nil
+	}
+}
+
+func generateSOA(records []dns.RR) (generateBody, error) {
+	soa, ok := records[0].(*dns.SOA)
+	if !ok || len(records) != 1 {
+		return generateBody{}, fmt.Errorf("expected a single SOA record, got %d records", len(records))
+	}
+
+	return generateBody{
+		attributes: []generateAttribute{
+			{"mname", cty.StringVal(soa.Ns)},
+			{"rname", cty.StringVal(soa.Mbox)},
+			{"refresh", cty.NumberIntVal(int64(soa.Refresh))},
+			{"retry", cty.NumberIntVal(int64(soa.Retry))},
+			{"expire", cty.NumberIntVal(int64(soa.Expire))},
+			{"minimum", cty.NumberIntVal(int64(soa.Minttl))},
+		},
+	}, nil
+}
@@ -0,0 +1,246 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/miekg/dns"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestGenerate(t *testing.T) {
+	zoneFile := filepath.Join(t.TempDir(), "db.example.com")
+	err := os.WriteFile(zoneFile, []byte(`$ORIGIN example.com.
+$TTL 300
+@ IN SOA ns.example.com. hostmaster.example.com. 1 3600 900 604800 300
+@ 3600 IN NS ns
+@ IN CAA 0 issue "letsencrypt.org"
+@ IN TYPE65534 \# 5 0d2a3c0000
+@ IN TYPE65534 \# 5 0d2a3c0001
+www IN A 192.0.2.80
+www 60 IN A 192.0.2.10
+WWW IN AAAA 2001:db8::80
+*.dev IN CNAME www
+long IN TXT "a" "b"
+sub IN NS ns.sub
+ns.sub IN A 192.0.2.54
+`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if err := Generate(context.Background(), []string{"-zone", "example.com.", "-zone-file", zoneFile}, &stdout, &stderr); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `# The following record sets are not supported by the provider and were skipped:
+#   example.com. CAA
+#   example.com. NS
+#   example.com. TYPE65534
+
+resource "dns_soa" "apex" {
+  zone    = "example.com."
+  mname   = "ns.example.com."
+  rname   = "hostmaster.example.com."
+  refresh = 3600
+  retry   = 900
+  expire  = 604800
+  minimum = 300
+  ttl     = 300
+}
+
+import {
+  to = dns_soa.apex
+  id = "example.com."
+}
+
+resource "dns_cname_record" "wildcard_dev" {
+  zone  = "example.com."
+  name  = "*.dev"
+  cname = "www.example.com."
+  ttl   = 300
+}
+
+import {
+  to = dns_cname_record.wildcard_dev
+  id = "*.dev.example.com."
+}
+
+resource "dns_txt_record_set" "long" {
+  zone   = "example.com."
+  name   = "long"
+  chunks = [["a", "b"]]
+  ttl    = 300
+}
+
+import {
+  to = dns_txt_record_set.long
+  id = "long.example.com."
+}
+
+resource "dns_ns_record_set" "sub" {
+  zone        = "example.com."
+  name        = "sub"
+  nameservers = ["ns.sub.example.com."]
+  ttl         = 300
+}
+
+import {
+  to = dns_ns_record_set.sub
+  id = "sub.example.com."
+}
+
+resource "dns_a_record_set" "www" {
+  zone      = "example.com."
+  name      = "www"
+  addresses = ["192.0.2.10", "192.0.2.80"]
+  ttl       = 60
+}
+
+import {
+  to = dns_a_record_set.www
+  id = "www.example.com."
+}
+
+resource "dns_aaaa_record_set" "www" {
+  zone      = "example.com."
+  name      = "www"
+  addresses = ["2001:db8::80"]
+  ttl       = 300
+}
+
+import {
+  to = dns_aaaa_record_set.www
+  id = "www.example.com."
+}
+`
+	if diff := cmp.Diff(expected, stdout.String()); diff != "" {
+		t.Errorf("unexpected configuration (-want +got):\n%s", diff)
+	}
+
+	expectedWarnings := `Warning: records outside of the zone or below its delegations are not authoritative, 1 were skipped
+Warning: example.com. CAA records are not supported by the provider and were skipped
+Warning: example.com. NS records are not supported by dns_ns_record_set and were skipped: Attribute "name" must be specified when "zone" is specified
+Warning: example.com. TYPE65534 records are not supported by the provider and were skipped
+`
+	if diff := cmp.Diff(expectedWarnings, stderr.String()); diff != "" {
+		t.Errorf("unexpected warnings (-want +got):\n%s", diff)
+	}
+}
+
+func TestGenerateValidate(t *testing.T) {
+	testCases := map[string]struct {
+		rrType  uint16
+		name    string
+		records string
+		valid   bool
+	}{
+		"a-apex": {
+			rrType:  dns.TypeA,
+			records: "example.com. 300 IN A 192.0.2.1",
+			valid:   true,
+		},
+		"ns": {
+			rrType:  dns.TypeNS,
+			name:    "sub",
+			records: "sub.example.com. 300 IN NS ns.example.net.",
+			valid:   true,
+		},
+		"ns-apex": {
+			rrType:  dns.TypeNS,
+			records: "example.com. 300 IN NS ns.example.net.",
+		},
+		"srv": {
+			rrType:  dns.TypeSRV,
+			name:    "_sip._tcp",
+			records: "_sip._tcp.example.com. 300 IN SRV 10 60 5060 sip.example.com.",
+			valid:   true,
+		},
+		"srv-apex": {
+			rrType:  dns.TypeSRV,
+			records: "example.com. 300 IN SRV 10 60 5060 sip.example.com.",
+		},
+		"mx": {
+			rrType:  dns.TypeMX,
+			records: "example.com. 300 IN MX 10 mail.example.com.",
+			valid:   true,
+		},
+		"mx-invalid-exchange": {
+			rrType:  dns.TypeMX,
+			records: "example.com. 300 IN MX 10 mail_server.example.com.",
+		},
+		"ds": {
+			rrType:  dns.TypeDS,
+			name:    "sub",
+			records: "sub.example.com. 300 IN DS 60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118",
+			valid:   true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			record, err := dns.NewRR(tc.records)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			res := generateResources[tc.rrType]
+			specific, err := res.body([]dns.RR{record})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			body := generateBody{
+				attributes: []generateAttribute{{"zone", cty.StringVal("example.com.")}},
+			}
+			if tc.name != "" {
+				body.attributes = append(body.attributes, generateAttribute{"name", cty.StringVal(tc.name)})
+			}
+			body.attributes = append(body.attributes, specific.attributes...)
+			body.attributes = append(body.attributes, generateAttribute{"ttl", cty.NumberIntVal(300)})
+			body.blocks = specific.blocks
+
+			r := res.newResource()
+			var schemaResp resource.SchemaResponse
+			r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+			diags := generateValidate(context.Background(), r, schemaResp.Schema, body)
+			if tc.valid && diags.HasError() {
+				t.Errorf("expected a valid configuration, got: %v", diags)
+			}
+			if !tc.valid && !diags.HasError() {
+				t.Error("expected an invalid configuration")
+			}
+		})
+	}
+}
+
+func TestGenerateLabel(t *testing.T) {
+	testCases := map[string]string{
+		"":           "apex",
+		"www":        "www",
+		"WWW.Dev":    "www_dev",
+		"*":          "wildcard",
+		"_sip._tcp":  "_sip__tcp",
+		"1www":       "_1www",
+		"-foo":       "_-foo",
+		"host-1.lab": "host-1_lab",
+	}
+
+	for name, expected := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if label := generateLabel(name); label != expected {
+				t.Errorf("expected label %q, got %q", expected, label)
+			}
+		})
+	}
+}
@@ -0,0 +1,321 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/idn"
+	"github.com/hashicorp/terraform-provider-dns/internal/validators/dnsvalidator"
+)
+
+var (
+	_ list.ListResource              = (*dnsRecordListResource)(nil)
+	_ list.ListResourceWithConfigure = (*dnsRecordListResource)(nil)
+)
+
+func NewDnsARecordSetListResource() list.ListResource {
+	return newDnsRecordListResource("_a_record_set", dns.TypeA, NewDnsARecordSetResource)
+}
+
+func NewDnsAAAARecordSetListResource() list.ListResource {
+	return newDnsRecordListResource("_aaaa_record_set", dns.TypeAAAA, NewDnsAAAARecordSetResource)
+}
+
+func NewDnsCDNSKEYRecordSetListResource() list.ListResource {
+	return newDnsRecordListResource("_cdnskey_record_set", dns.TypeCDNSKEY, NewDnsCDNSKEYRecordSetResource)
+}
+
+func NewDnsCDSRecordSetListResource() list.ListResource {
+	return newDnsRecordListResource("_cds_record_set", dns.TypeCDS, NewDnsCDSRecordSetResource)
+}
+
+func NewDnsCNAMERecordListResource() list.ListResource {
+	return newDnsRecordListResource("_cname_record", dns.TypeCNAME, NewDnsCNAMERecordResource)
+}
+
+func NewDnsDNSKEYRecordSetListResource() list.ListResource {
+	return newDnsRecordListResource("_dnskey_record_set", dns.TypeDNSKEY, NewDnsDNSKEYRecordSetResource)
+}
+
+func NewDnsDSRecordSetListResource() list.ListResource {
+	return newDnsRecordListResource("_ds_record_set", dns.TypeDS, NewDnsDSRecordSetResource)
+}
+
+func NewDnsMXRecordSetListResource() list.ListResource {
+	return newDnsRecordListResource("_mx_record_set", dns.TypeMX, NewDnsMXRecordSetResource)
+}
+
+func NewDnsNSRecordSetListResource() list.ListResource {
+	return newDnsRecordListResource("_ns_record_set", dns.TypeNS, NewDnsNSRecordSetResource)
+}
+
+func NewDnsPTRRecordListResource() list.ListResource {
+	return newDnsRecordListResource("_ptr_record", dns.TypePTR, NewDnsPTRRecordResource)
+}
+
+func NewDnsSRVRecordSetListResource() list.ListResource {
+	return newDnsRecordListResource("_srv_record_set", dns.TypeSRV, NewDnsSRVRecordSetResource)
+}
+
+func NewDnsTXTRecordSetListResource() list.ListResource {
+	return newDnsRecordListResource("_txt_record_set", dns.TypeTXT, NewDnsTXTRecordSetResource)
+}
+
+// dnsRecordListResource lists the record sets of one type in a zone, which
+// are found with a zone transfer. The attributes of each record set are read
+// by the managed resource itself, so they match those of an import.
+type dnsRecordListResource struct {
+	typeName    string
+	rrType      uint16
+	newResource func() resource.Resource
+	client      *DNSClient
+}
+
+func newDnsRecordListResource(typeName string, rrType uint16, newResource func() resource.Resource) list.ListResource {
+	return &dnsRecordListResource{
+		typeName:    typeName,
+		rrType:      rrType,
+		newResource: newResource,
+	}
+}
+
+func (d *dnsRecordListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + d.typeName
+}
+
+func (d *dnsRecordListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Lists the %s records of a zone, which are transferred with AXFR from the DNS "+
+			"server of the provider. The transfer is signed with the `update` credentials of the provider, so "+
+			"the server must allow zone transfers for them. Records below a delegation are not listed.",
+			dns.TypeToString[d.rrType]),
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					dnsvalidator.IsZoneNameValid(),
+				},
+				Description: "DNS zone to list the records of. It must be an FQDN, that is, include the trailing dot.",
+			},
+		},
+	}
+}
+
+func (d *dnsRecordListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*DNSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *DNSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *dnsRecordListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config recordListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	zone := config.Zone.ValueString()
+
+	records, err := transfer(zone, d.client)
+	if err != nil {
+		diags.AddError("Error transferring DNS zone:", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	names, err := recordListNames(zone, records, d.rrType)
+	if err != nil {
+		diags.AddError("Error transferring DNS zone:", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i, name := range names {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			if !push(d.result(ctx, req, dnsConfig{Name: name, Zone: zone})) {
+				return
+			}
+		}
+	}
+}
+
+// result returns the list result of the record set at config, reading the
+// record set with the managed resource if the resource must be included.
+func (d *dnsRecordListResource) result(ctx context.Context, req list.ListRequest, config dnsConfig) list.ListResult {
+	result := req.NewListResult(ctx)
+
+	fqdn := resourceFQDN_framework(config)
+	result.DisplayName = fqdn
+
+	result.Diagnostics.Append(result.Identity.Set(ctx, recordIdentity(config, d.rrType))...)
+	if result.Diagnostics.HasError() || !req.IncludeResource {
+		return result
+	}
+
+	r := d.newResource()
+	if r, ok := r.(resource.ResourceWithConfigure); ok {
+		configureResp := resource.ConfigureResponse{}
+		r.Configure(ctx, resource.ConfigureRequest{ProviderData: d.client}, &configureResp)
+		result.Diagnostics.Append(configureResp.Diagnostics...)
+		if result.Diagnostics.HasError() {
+			return result
+		}
+	}
+
+	// The state is seeded like the ImportState method of the resource does
+	state := tfsdk.State{
+		Schema: result.Resource.Schema,
+		Raw:    result.Resource.Raw.Copy(),
+	}
+	result.Diagnostics.Append(state.SetAttribute(ctx, path.Root("id"), fqdn)...)
+	result.Diagnostics.Append(state.SetAttribute(ctx, path.Root("fqdn"), fqdn)...)
+	result.Diagnostics.Append(state.SetAttribute(ctx, path.Root("zone"), config.Zone)...)
+	if config.Name != "" {
+		result.Diagnostics.Append(state.SetAttribute(ctx, path.Root("name"), config.Name)...)
+	}
+	if result.Diagnostics.HasError() {
+		return result
+	}
+
+	readResp := resource.ReadResponse{
+		State: state,
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: result.Identity.Schema,
+			Raw:    result.Identity.Raw.Copy(),
+		},
+	}
+	r.Read(ctx, resource.ReadRequest{State: state, Identity: readResp.Identity}, &readResp)
+	result.Diagnostics.Append(readResp.Diagnostics...)
+	if result.Diagnostics.HasError() {
+		return result
+	}
+
+	if readResp.State.Raw.IsNull() {
+		result.Diagnostics.Append(diag.NewWarningDiagnostic("DNS record set removed:",
+			fmt.Sprintf("The %s records of %s were removed while the zone was listed.", dns.TypeToString[d.rrType], fqdn)))
+		return result
+	}
+
+	result.Resource.Raw = readResp.State.Raw
+
+	return result
+}
+
+// recordListNames returns the names, relative to zone, of the record sets of
+// type rrType in the records of the zone, sorted and in the form the zone is
+// given in. Only the NS and DS records of a delegation are authoritative, so
+// other records at or below a delegation, such as glue, are left out.
+func recordListNames(zone string, records []dns.RR, rrType uint16) ([]string, error) {
+	ascii, err := idn.ToASCII(zone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid internationalized domain name %q: %w", zone, err)
+	}
+	apex := dns.CanonicalName(ascii)
+	delegations := zoneDelegations(apex, records)
+
+	seen := make(map[string]bool)
+	var names []string
+
+	for _, record := range records {
+		owner := dns.CanonicalName(record.Header().Name)
+		if record.Header().Rrtype != rrType || seen[owner] || !isAuthoritative(apex, delegations, record) {
+			continue
+		}
+
+		seen[owner] = true
+		names = append(names, zoneRelativeName(zone, ascii, record.Header().Name))
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// zoneRelativeName returns the name of owner relative to zone, whose ASCII
+// form is ascii. The name is converted to Unicode if the zone is given in
+// Unicode, as owner names are always transferred in ASCII.
+func zoneRelativeName(zone, ascii, owner string) string {
+	labels := dns.SplitDomainName(owner)
+	name := strings.Join(labels[:len(labels)-dns.CountLabel(ascii)], ".")
+	if ascii != zone {
+		if unicode, err := idn.ToUnicode(name); err == nil {
+			name = unicode
+		}
+	}
+
+	return name
+}
+
+// zoneDelegations returns the canonical names of the delegations in the
+// records of the zone at apex, that is, the owners of its NS records other
+// than the apex.
+func zoneDelegations(apex string, records []dns.RR) []string {
+	var delegations []string
+	for _, record := range records {
+		owner := dns.CanonicalName(record.Header().Name)
+		if record.Header().Rrtype == dns.TypeNS && owner != apex {
+			delegations = append(delegations, owner)
+		}
+	}
+
+	return delegations
+}
+
+// isAuthoritative returns whether record is authoritative data of the zone at
+// apex, that is, it is within the zone and it is not below one of its
+// delegations, or it is the NS or DS record set of a delegation.
+func isAuthoritative(apex string, delegations []string, record dns.RR) bool {
+	owner := dns.CanonicalName(record.Header().Name)
+	if !dns.IsSubDomain(apex, owner) {
+		return false
+	}
+
+	for _, delegation := range delegations {
+		if !dns.IsSubDomain(delegation, owner) {
+			continue
+		}
+		if rrType := record.Header().Rrtype; owner != delegation || (rrType != dns.TypeNS && rrType != dns.TypeDS) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type recordListResourceModel struct {
+	Zone types.String `tfsdk:"zone"`
+}
@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/miekg/dns"
+)
+
+func TestRecordListNames(t *testing.T) {
+	var records []dns.RR
+	for _, s := range []string{
+		"example.com. 300 IN SOA ns.example.com. hostmaster.example.com. 1 3600 900 604800 300",
+		"example.com. 300 IN NS ns.example.com.",
+		"example.com. 300 IN A 192.0.2.1",
+		"ns.example.com. 300 IN A 192.0.2.53",
+		"WWW.example.com. 300 IN A 192.0.2.80",
+		"www.example.com. 300 IN A 192.0.2.81",
+		"www.example.com. 300 IN TXT \"hello\"",
+		"sub.example.com. 300 IN NS ns.sub.example.com.",
+		"sub.example.com. 300 IN DS 60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118",
+		"ns.sub.example.com. 300 IN A 192.0.2.54",
+		"xn--bcher-kva.example.com. 300 IN A 192.0.2.82",
+		"xn--exmple-cua.com. 300 IN A 192.0.2.2",
+		"xn--bcher-kva.xn--exmple-cua.com. 300 IN A 192.0.2.83",
+		"example.com. 300 IN SOA ns.example.com. hostmaster.example.com. 1 3600 900 604800 300",
+	} {
+		rr, err := dns.NewRR(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, rr)
+	}
+
+	testCases := map[string]struct {
+		zone     string
+		rrType   uint16
+		expected []string
+	}{
+		"a": {
+			zone:     "example.com.",
+			rrType:   dns.TypeA,
+			expected: []string{"", "WWW", "ns", "xn--bcher-kva"},
+		},
+		"a-unicode": {
+			zone:     "exämple.com.",
+			rrType:   dns.TypeA,
+			expected: []string{"", "bücher"},
+		},
+		"ns": {
+			zone:     "example.com.",
+			rrType:   dns.TypeNS,
+			expected: []string{"", "sub"},
+		},
+		"ds": {
+			zone:     "example.com.",
+			rrType:   dns.TypeDS,
+			expected: []string{"sub"},
+		},
+		"txt": {
+			zone:     "example.com.",
+			rrType:   dns.TypeTXT,
+			expected: []string{"www"},
+		},
+		"mx": {
+			zone:     "example.com.",
+			rrType:   dns.TypeMX,
+			expected: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			names, err := recordListNames(testCase.zone, records, testCase.rrType)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(testCase.expected, names); diff != "" {
+				t.Errorf("unexpected names (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAccDnsARecordSetList_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDnsARecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsARecordSetList_records,
+			},
+			{
+				Query:  true,
+				Config: testAccDnsARecordSetList_query,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectIdentity("dns_a_record_set.test", map[string]knownvalue.Check{
+						"zone": knownvalue.StringExact("example.com."),
+						"name": knownvalue.StringExact("list"),
+						"type": knownvalue.StringExact("A"),
+					}),
+					querycheck.ExpectResourceDisplayName("dns_a_record_set.test", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						"zone": knownvalue.StringExact("example.com."),
+						"name": knownvalue.StringExact("list"),
+						"type": knownvalue.StringExact("A"),
+					}), knownvalue.StringExact("list.example.com.")),
+					querycheck.ExpectResourceKnownValues("dns_a_record_set.test", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						"zone": knownvalue.StringExact("example.com."),
+						"name": knownvalue.StringExact("list"),
+						"type": knownvalue.StringExact("A"),
+					}), []querycheck.KnownValueCheck{
+						{
+							Path:       tfjsonpath.New("addresses"),
+							KnownValue: knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("192.168.0.1"), knownvalue.StringExact("192.168.0.2")}),
+						},
+						{
+							Path:       tfjsonpath.New("ttl"),
+							KnownValue: knownvalue.Int64Exact(300),
+						},
+					}),
+				},
+			},
+		},
+	})
+}
+
+var testAccDnsARecordSetList_records = `
+resource "dns_a_record_set" "list" {
+  zone      = "example.com."
+  name      = "list"
+  addresses = ["192.168.0.1", "192.168.0.2"]
+  ttl       = 300
+}
+`
+
+var testAccDnsARecordSetList_query = `
+provider "dns" {}
+
+list "dns_a_record_set" "test" {
+  provider         = dns
+  include_resource = true
+
+  config {
+    zone = "example.com."
+  }
+}
+`
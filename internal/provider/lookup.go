@@ -3,7 +3,25 @@
 
 package provider
 
-import "net"
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/dnssec"
+	"github.com/hashicorp/terraform-provider-dns/internal/idn"
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
+)
+
+// resolvConf is the configuration of the system resolver used by validating
+// lookups and by SOA lookups when no resolver is set. It does not exist on
+// Windows.
+const resolvConf = "/etc/resolv.conf"
 
 func lookupIP(host string) ([]string, []string, error) {
 	records, err := net.LookupIP(host)
@@ -23,3 +41,442 @@ func lookupIP(host string) ([]string, []string, error) {
 
 	return a, aaaa, nil
 }
+
+// dnssecLookupConfig holds the attributes shared by the data sources which
+// can validate their lookups with DNSSEC. Without validation, lookups go
+// through the system resolver of the net package.
+type dnssecLookupConfig struct {
+	DNSSEC       types.Bool   `tfsdk:"dnssec"`
+	TrustAnchors types.List   `tfsdk:"trust_anchors"`
+	Resolver     types.String `tfsdk:"resolver"`
+	DNSSECStatus types.String `tfsdk:"dnssec_status"`
+}
+
+// dnssecLookupAttributes adds the attributes of dnssecLookupConfig to the
+// attributes of a data source.
+func dnssecLookupAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["dnssec"] = schema.BoolAttribute{
+		Optional: true,
+		Description: "Validate the records with DNSSEC. The records are queried with the DO bit set from `resolver`, " +
+			"and their signatures are verified up to a trust anchor. The lookup fails if the validation fails. " +
+			"Defaults to `false`.",
+	}
+	attributes["trust_anchors"] = schema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "DS or DNSKEY records in presentation format to use as trust anchors when `dnssec` is enabled, " +
+			"such as `example.com. IN DS 370 13 2 BE74...247C`. Defaults to the DS records of the root zone key " +
+			"signing keys.",
+	}
+	attributes["resolver"] = schema.StringAttribute{
+		Optional: true,
+		Description: "The recursive resolver to query when the records are not looked up through the system " +
+			"resolver, which is when `dnssec` is enabled and for SOA records, as a host with an optional port, " +
+			"such as `192.0.2.53` or `[2001:db8::53]:5353`. Defaults to the first nameserver of `/etc/resolv.conf` " +
+			"that answers, which has to be set on platforms without that file, such as Windows.",
+	}
+	attributes["dnssec_status"] = schema.StringAttribute{
+		Computed: true,
+		Description: "The result of the DNSSEC validation when `dnssec` is enabled: `secure`, or `insecure` if the " +
+			"records are in a zone proven to be unsigned. Use a postcondition to require `secure` records.",
+	}
+
+	return attributes
+}
+
+// resolverExchange returns a function which sends a message to resolver, or
+// to the first nameserver of the system resolver configuration that answers
+// if resolver is empty, retrying over TCP if the answer is truncated.
+func resolverExchange(ctx context.Context, resolver string) (func(msg *dns.Msg) (*dns.Msg, error), error) {
+	addrs, err := resolverAddrs(resolver)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(msg *dns.Msg) (*dns.Msg, error) {
+		var err error
+		for _, addr := range addrs {
+			var r *dns.Msg
+			r, _, err = new(dns.Client).ExchangeContext(ctx, msg, addr)
+			if err == nil && r.Truncated {
+				r, _, err = (&dns.Client{Net: "tcp"}).ExchangeContext(ctx, msg, addr)
+			}
+			if err == nil {
+				return r, nil
+			}
+		}
+		return nil, err
+	}, nil
+}
+
+// resolverLookup returns the records of rrType in the answer to the query of
+// name sent with exchange.
+func resolverLookup(exchange func(msg *dns.Msg) (*dns.Msg, error), name string, rrType uint16) ([]dns.RR, error) {
+	msg := new(dns.Msg)
+	msg.SetQuestion(name, rrType)
+
+	r, err := exchange(msg)
+	if err != nil {
+		return nil, err
+	}
+	if r.Rcode != dns.RcodeSuccess {
+		return nil, fmt.Errorf("error querying %s records: %s", dns.Type(rrType), dns.RcodeToString[r.Rcode])
+	}
+
+	var records []dns.RR
+	for _, record := range r.Answer {
+		if record.Header().Rrtype == rrType {
+			records = append(records, record)
+		}
+	}
+
+	return records, nil
+}
+
+// resolverAddrs returns the address of resolver, with port 53 if it has
+// none, or the addresses of the nameservers of the system resolver
+// configuration if resolver is empty.
+func resolverAddrs(resolver string) ([]string, error) {
+	if resolver != "" {
+		if _, _, err := net.SplitHostPort(resolver); err == nil {
+			return []string{resolver}, nil
+		}
+		return []string{net.JoinHostPort(strings.Trim(resolver, "[]"), "53")}, nil
+	}
+
+	conf, err := dns.ClientConfigFromFile(resolvConf)
+	if err != nil {
+		return nil, fmt.Errorf("error reading resolver configuration, set `resolver` to query a resolver directly: %w", err)
+	}
+	if len(conf.Servers) == 0 {
+		return nil, fmt.Errorf("no nameserver found in %s", resolvConf)
+	}
+
+	addrs := make([]string, 0, len(conf.Servers))
+	for _, server := range conf.Servers {
+		addrs = append(addrs, net.JoinHostPort(server, conf.Port))
+	}
+
+	return addrs, nil
+}
+
+// lookup looks up the records of rrType for name and validates them up to
+// the configured trust anchors, setting the DNSSEC status.
+func (c *dnssecLookupConfig) lookup(ctx context.Context, name string, rrType uint16) ([]dns.RR, error) {
+	anchors := dnssec.RootAnchors()
+	if !c.TrustAnchors.IsNull() && !c.TrustAnchors.IsUnknown() {
+		var values []string
+		if diags := c.TrustAnchors.ElementsAs(ctx, &values, false); diags.HasError() {
+			return nil, fmt.Errorf("error reading trust anchors")
+		}
+
+		var err error
+		if anchors, err = dnssec.ParseAnchors(values); err != nil {
+			return nil, err
+		}
+	}
+
+	exchange, err := resolverExchange(ctx, c.Resolver.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	fqdn, err := idn.ToASCII(dns.Fqdn(name))
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := dnssec.NewValidator(exchange, anchors).Lookup(fqdn, rrType)
+	if err != nil {
+		return nil, err
+	}
+
+	// Bogus records must not be used, as described in RFC 4035 section 4.3
+	if result.Status == dnssec.Bogus {
+		return nil, fmt.Errorf("DNSSEC validation failed: %s", result.Reason)
+	}
+
+	c.DNSSECStatus = types.StringValue(string(result.Status))
+
+	var records []dns.RR
+	for _, record := range result.Records {
+		if record.Header().Rrtype == rrType {
+			records = append(records, record)
+		}
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("no %s records found", dns.TypeToString[rrType])
+	}
+
+	return records, nil
+}
+
+// lookupIP returns the addresses of the A or AAAA records of host.
+func (c *dnssecLookupConfig) lookupIP(ctx context.Context, host string, rrType uint16) ([]string, error) {
+	if !c.DNSSEC.ValueBool() {
+		c.DNSSECStatus = types.StringNull()
+
+		a, aaaa, err := lookupIP(host)
+		if rrType == dns.TypeAAAA {
+			return aaaa, err
+		}
+		return a, err
+	}
+
+	records, err := c.lookup(ctx, host, rrType)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make([]string, 0, len(records))
+	for _, record := range records {
+		var addr string
+		if rrType == dns.TypeAAAA {
+			addr, _, err = rdata.AAAA(record)
+		} else {
+			addr, _, err = rdata.A(record)
+		}
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+
+	return addrs, nil
+}
+
+// lookupCNAME returns the target of the CNAME record of host.
+func (c *dnssecLookupConfig) lookupCNAME(ctx context.Context, host string) (string, error) {
+	if !c.DNSSEC.ValueBool() {
+		c.DNSSECStatus = types.StringNull()
+		return net.LookupCNAME(host)
+	}
+
+	records, err := c.lookup(ctx, host, dns.TypeCNAME)
+	if err != nil {
+		return "", err
+	}
+
+	cname, _, err := rdata.CNAME(records[0])
+	return cname, err
+}
+
+// lookupMX returns the MX records of domain.
+func (c *dnssecLookupConfig) lookupMX(ctx context.Context, domain string) ([]*net.MX, error) {
+	if !c.DNSSEC.ValueBool() {
+		c.DNSSECStatus = types.StringNull()
+		return net.LookupMX(domain)
+	}
+
+	records, err := c.lookup(ctx, domain, dns.TypeMX)
+	if err != nil {
+		return nil, err
+	}
+
+	mx := make([]*net.MX, 0, len(records))
+	for _, record := range records {
+		preference, exchange, _, err := rdata.MX(record)
+		if err != nil {
+			return nil, err
+		}
+		mx = append(mx, &net.MX{Host: exchange, Pref: uint16(preference)})
+	}
+
+	return mx, nil
+}
+
+// lookupNS returns the NS records of host.
+func (c *dnssecLookupConfig) lookupNS(ctx context.Context, host string) ([]*net.NS, error) {
+	if !c.DNSSEC.ValueBool() {
+		c.DNSSECStatus = types.StringNull()
+		return net.LookupNS(host)
+	}
+
+	records, err := c.lookup(ctx, host, dns.TypeNS)
+	if err != nil {
+		return nil, err
+	}
+
+	ns := make([]*net.NS, 0, len(records))
+	for _, record := range records {
+		nameserver, _, err := rdata.NS(record)
+		if err != nil {
+			return nil, err
+		}
+		ns = append(ns, &net.NS{Host: nameserver})
+	}
+
+	return ns, nil
+}
+
+// lookupAddr returns the names of the PTR records of ipAddress.
+func (c *dnssecLookupConfig) lookupAddr(ctx context.Context, ipAddress string) ([]string, error) {
+	if !c.DNSSEC.ValueBool() {
+		c.DNSSECStatus = types.StringNull()
+		return net.LookupAddr(ipAddress)
+	}
+
+	name, err := dns.ReverseAddr(ipAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := c.lookup(ctx, name, dns.TypePTR)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(records))
+	for _, record := range records {
+		ptr, _, err := rdata.PTR(record)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, ptr)
+	}
+
+	return names, nil
+}
+
+// lookupSRV returns the SRV records of service.
+func (c *dnssecLookupConfig) lookupSRV(ctx context.Context, service string) ([]*net.SRV, error) {
+	if !c.DNSSEC.ValueBool() {
+		c.DNSSECStatus = types.StringNull()
+		_, records, err := net.LookupSRV("", "", service)
+		return records, err
+	}
+
+	records, err := c.lookup(ctx, service, dns.TypeSRV)
+	if err != nil {
+		return nil, err
+	}
+
+	srv := make([]*net.SRV, 0, len(records))
+	for _, record := range records {
+		priority, weight, port, target, _, err := rdata.SRV(record)
+		if err != nil {
+			return nil, err
+		}
+		srv = append(srv, &net.SRV{
+			Target:   target,
+			Port:     uint16(port),
+			Priority: uint16(priority),
+			Weight:   uint16(weight),
+		})
+	}
+
+	return srv, nil
+}
+
+// lookupTXT returns the TXT records of host, each with its character-strings
+// concatenated.
+func (c *dnssecLookupConfig) lookupTXT(ctx context.Context, host string) ([]string, error) {
+	if !c.DNSSEC.ValueBool() {
+		c.DNSSECStatus = types.StringNull()
+		return net.LookupTXT(host)
+	}
+
+	records, err := c.lookup(ctx, host, dns.TypeTXT)
+	if err != nil {
+		return nil, err
+	}
+
+	txt := make([]string, 0, len(records))
+	for _, record := range records {
+		chunks, _, err := rdata.TXT(record)
+		if err != nil {
+			return nil, err
+		}
+		txt = append(txt, strings.Join(chunks, ""))
+	}
+
+	return txt, nil
+}
+
+// lookupSOA returns the SOA record of zone. As the net package cannot look up
+// SOA records, they are queried from the resolver even when they are not
+// validated.
+func (c *dnssecLookupConfig) lookupSOA(ctx context.Context, zone string) (*dns.SOA, error) {
+	if c.DNSSEC.ValueBool() {
+		records, err := c.lookup(ctx, zone, dns.TypeSOA)
+		if err != nil {
+			return nil, err
+		}
+
+		soa, ok := records[0].(*dns.SOA)
+		if !ok {
+			return nil, fmt.Errorf("didn't get a SOA record")
+		}
+		return soa, nil
+	}
+
+	c.DNSSECStatus = types.StringNull()
+
+	exchange, err := resolverExchange(ctx, c.Resolver.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	fqdn, err := idn.ToASCII(dns.Fqdn(zone))
+	if err != nil {
+		return nil, err
+	}
+
+	msg := new(dns.Msg)
+	msg.SetQuestion(fqdn, dns.TypeSOA)
+
+	r, err := exchange(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return soaAnswer(fqdn, r)
+}
+
+// querySerial returns the serial number of the SOA record of zone, which is
+// queried without recursion from the nameserver at addr. The answer must be
+// authoritative, so that the serial is the one of the zone on the nameserver.
+func querySerial(ctx context.Context, zone, addr string) (uint32, error) {
+	fqdn, err := idn.ToASCII(dns.Fqdn(zone))
+	if err != nil {
+		return 0, err
+	}
+
+	msg := new(dns.Msg)
+	msg.SetQuestion(fqdn, dns.TypeSOA)
+	msg.RecursionDesired = false
+
+	r, _, err := new(dns.Client).ExchangeContext(ctx, msg, addr)
+	if err == nil && r.Truncated {
+		r, _, err = (&dns.Client{Net: "tcp"}).ExchangeContext(ctx, msg, addr)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if r.Rcode == dns.RcodeSuccess && !r.Authoritative {
+		return 0, fmt.Errorf("nameserver %s is not authoritative for %s", addr, zone)
+	}
+
+	soa, err := soaAnswer(fqdn, r)
+	if err != nil {
+		return 0, err
+	}
+
+	return soa.Serial, nil
+}
+
+// soaAnswer returns the SOA record of fqdn in the answer section of r.
+func soaAnswer(fqdn string, r *dns.Msg) (*dns.SOA, error) {
+	if r.Rcode != dns.RcodeSuccess {
+		return nil, fmt.Errorf("error querying SOA record: %s", dns.RcodeToString[r.Rcode])
+	}
+
+	for _, record := range r.Answer {
+		if soa, ok := record.(*dns.SOA); ok && dns.CanonicalName(soa.Hdr.Name) == dns.CanonicalName(fqdn) {
+			return soa, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no SOA records found")
+}
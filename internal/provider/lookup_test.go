@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResolverAddrs(t *testing.T) {
+	testCases := map[string][]string{
+		"192.0.2.53":           {"192.0.2.53:53"},
+		"192.0.2.53:5353":      {"192.0.2.53:5353"},
+		"2001:db8::53":         {"[2001:db8::53]:53"},
+		"[2001:db8::53]":       {"[2001:db8::53]:53"},
+		"[2001:db8::53]:5353":  {"[2001:db8::53]:5353"},
+		"resolver.example.com": {"resolver.example.com:53"},
+	}
+
+	for resolver, expected := range testCases {
+		t.Run(resolver, func(t *testing.T) {
+			addrs, err := resolverAddrs(resolver)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(expected, addrs); diff != "" {
+				t.Errorf("unexpected addresses (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -4,18 +4,17 @@
 package provider
 
 import (
-	"context"
 	"fmt"
+	"io"
 	"log"
 	"net"
-	"os"
-	"strconv"
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/idn"
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
 )
 
 const (
@@ -25,443 +24,12 @@ const (
 	defaultTransport = "udp"
 )
 
-// New returns a *schema.Provider for DNS dynamic updates.
-func New() *schema.Provider {
-	return &schema.Provider{
-		Schema: map[string]*schema.Schema{
-			"update": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "When the provider is used for DNS updates, this block is required. Only one `update` block may be in the configuration.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"server": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							DefaultFunc: schema.EnvDefaultFunc("DNS_UPDATE_SERVER", nil),
-							Description: "The hostname or IP address of the DNS server to send updates to. " +
-								"Value can also be sourced from the DNS_UPDATE_SERVER environment variable.",
-						},
-						"port": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							DefaultFunc: func() (interface{}, error) {
-								if envPortStr := os.Getenv("DNS_UPDATE_PORT"); envPortStr != "" {
-									port, err := strconv.Atoi(envPortStr)
-									if err != nil {
-										err = fmt.Errorf("invalid DNS_UPDATE_PORT environment variable: %s", err)
-									}
-									return port, err
-								}
-
-								return defaultPort, nil
-							},
-							Description: "The target UDP port on the server where updates are sent to. Defaults to `53`. " +
-								"Value can also be sourced from the DNS_UPDATE_PORT environment variable.",
-						},
-						"transport": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							DefaultFunc: schema.EnvDefaultFunc("DNS_UPDATE_TRANSPORT", defaultTransport),
-							Description: "Transport to use for DNS queries. Valid values are `udp`, `udp4`, `udp6`, " +
-								"`tcp`, `tcp4`, or `tcp6`. Any UDP transport will retry automatically with the " +
-								"equivalent TCP transport in the event of a truncated response. Defaults to `udp`. " +
-								"Value can also be sourced from the DNS_UPDATE_TRANSPORT environment variable.",
-						},
-						"timeout": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							DefaultFunc: schema.EnvDefaultFunc("DNS_UPDATE_TIMEOUT", defaultTimeout),
-							Description: "Timeout for DNS queries. Valid values are durations expressed as `500ms`, " +
-								"etc. or a plain number which is treated as whole seconds. " +
-								"Value can also be sourced from the DNS_UPDATE_TIMEOUT environment variable.",
-						},
-						"retries": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							DefaultFunc: func() (interface{}, error) {
-								if env := os.Getenv("DNS_UPDATE_RETRIES"); env != "" {
-									retries, err := strconv.Atoi(env)
-									if err != nil {
-										err = fmt.Errorf("invalid DNS_UPDATE_RETRIES environment variable: %s", err)
-									}
-									return retries, err
-								}
-
-								return defaultRetries, nil
-							},
-							Description: "How many times to retry on connection timeout. Defaults to `3`. " +
-								"Value can also be sourced from the DNS_UPDATE_RETRIES environment variable.",
-						},
-						"recursive": {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Default:     false,
-							Description: "Enable the Recursion Desired (RD) flag on DNS queries",
-						},
-						"key_name": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							DefaultFunc: schema.EnvDefaultFunc("DNS_UPDATE_KEYNAME", nil),
-							Description: "The name of the TSIG key used to sign the DNS update messages. " +
-								"Value can also be sourced from the DNS_UPDATE_KEYNAME environment variable.",
-						},
-						"key_algorithm": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							DefaultFunc: schema.EnvDefaultFunc("DNS_UPDATE_KEYALGORITHM", nil),
-							Description: "Required if `key_name` is set. When using TSIG authentication, the " +
-								"algorithm to use for HMAC. Valid values are `hmac-md5`, `hmac-sha1`, `hmac-sha256` " +
-								"or `hmac-sha512`. " +
-								"Value can also be sourced from the DNS_UPDATE_KEYALGORITHM environment variable.",
-						},
-						"key_secret": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							DefaultFunc: schema.EnvDefaultFunc("DNS_UPDATE_KEYSECRET", nil),
-							Description: "Required if `key_name` is set\nA Base64-encoded string containing the " +
-								"shared secret to be used for TSIG. " +
-								"Value can also be sourced from the DNS_UPDATE_KEYSECRET environment variable.",
-						},
-						"gssapi": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Description: "A `gssapi` block. Only one `gssapi` block may be in the configuration. " +
-								"Conflicts with use of `key_name`, `key_algorithm` and `key_secret`.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"realm": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										DefaultFunc: schema.EnvDefaultFunc("DNS_UPDATE_REALM", nil),
-										Description: "The Kerberos realm or Active Directory domain. " +
-											"Value can also be sourced from the DNS_UPDATE_REALM environment variable.",
-									},
-									"username": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										DefaultFunc: schema.EnvDefaultFunc("DNS_UPDATE_USERNAME", nil),
-										Description: "The name of the user to authenticate as. If not set the current " +
-											"user session will be used. " +
-											"Value can also be sourced from the DNS_UPDATE_USERNAME environment variable.",
-									},
-									"password": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										DefaultFunc: schema.EnvDefaultFunc("DNS_UPDATE_PASSWORD", nil),
-										Sensitive:   true,
-										Description: "This or `keytab` is required if `username` is set. The matching " +
-											"password for `username`. " +
-											"Value can also be sourced from the DNS_UPDATE_PASSWORD environment variable.",
-									},
-									"keytab": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										DefaultFunc: schema.EnvDefaultFunc("DNS_UPDATE_KEYTAB", nil),
-										Description: "This or `password` is required if `username` is set, not " +
-											"supported on Windows. The path to a keytab file containing a key for " +
-											"`username`. " +
-											"Value can also be sourced from the DNS_UPDATE_KEYTAB environment variable.",
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-
-		ResourcesMap: map[string]*schema.Resource{
-			"dns_a_record_set":    resourceDnsARecordSet(),
-			"dns_aaaa_record_set": resourceDnsAAAARecordSet(),
-		},
-
-		ConfigureContextFunc: configureProvider,
-	}
-}
-
-func configureProvider(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-
-	var server, transport, timeout, keyname, keyalgo, keysecret, realm, username, password, keytab string
-	var port, retries int
-	var duration time.Duration
-	var gssapi, recursive bool
-
-	// if the update block is missing, schema.EnvDefaultFunc is not called
-	if v, ok := d.GetOk("update"); ok {
-		//nolint:forcetypeassert
-		update := v.([]interface{})[0].(map[string]interface{})
-		if val, ok := update["port"]; ok {
-			//nolint:forcetypeassert
-			port = val.(int)
-		}
-		if val, ok := update["server"]; ok {
-			//nolint:forcetypeassert
-			server = val.(string)
-		}
-		if val, ok := update["transport"]; ok {
-			//nolint:forcetypeassert
-			transport = val.(string)
-		}
-		if val, ok := update["timeout"]; ok {
-			//nolint:forcetypeassert
-			timeout = val.(string)
-		}
-		if val, ok := update["retries"]; ok {
-			//nolint:forcetypeassert
-			retries = val.(int)
-		}
-		if val, ok := update["recursive"]; ok {
-			//nolint:forcetypeassert
-			recursive = val.(bool)
-		}
-		if val, ok := update["key_name"]; ok {
-			//nolint:forcetypeassert
-			keyname = val.(string)
-		}
-		if val, ok := update["key_algorithm"]; ok {
-			//nolint:forcetypeassert
-			keyalgo = val.(string)
-		}
-		if val, ok := update["key_secret"]; ok {
-			//nolint:forcetypeassert
-			keysecret = val.(string)
-		}
-		//nolint:forcetypeassert
-		if val, ok := update["gssapi"]; ok && len(val.([]interface{})) > 0 {
-			//nolint:forcetypeassert
-			g := val.([]interface{})[0].(map[string]interface{})
-			if val, ok := g["realm"]; ok {
-				//nolint:forcetypeassert
-				realm = val.(string)
-			}
-			if val, ok := g["username"]; ok {
-				//nolint:forcetypeassert
-				username = val.(string)
-			}
-			if val, ok := g["password"]; ok {
-				//nolint:forcetypeassert
-				password = val.(string)
-			}
-			if val, ok := g["keytab"]; ok {
-				//nolint:forcetypeassert
-				keytab = val.(string)
-			}
-			gssapi = true
-		}
-	} else {
-		if len(os.Getenv("DNS_UPDATE_SERVER")) > 0 {
-			server = os.Getenv("DNS_UPDATE_SERVER")
-		} else {
-			return nil, nil
-		}
-		if len(os.Getenv("DNS_UPDATE_PORT")) > 0 {
-			var err error
-			portStr := os.Getenv("DNS_UPDATE_PORT")
-			port, err = strconv.Atoi(portStr)
-			if err != nil {
-				return nil, diag.Errorf("invalid DNS_UPDATE_PORT environment variable: %s", err)
-			}
-		} else {
-			port = defaultPort
-		}
-		if len(os.Getenv("DNS_UPDATE_TRANSPORT")) > 0 {
-			transport = os.Getenv("DNS_UPDATE_TRANSPORT")
-		} else {
-			transport = defaultTransport
-		}
-		if len(os.Getenv("DNS_UPDATE_TIMEOUT")) > 0 {
-			timeout = os.Getenv("DNS_UPDATE_TIMEOUT")
-		} else {
-			timeout = defaultTimeout
-		}
-		if len(os.Getenv("DNS_UPDATE_RETRIES")) > 0 {
-			var err error
-			env := os.Getenv("DNS_UPDATE_RETRIES")
-			retries, err = strconv.Atoi(env)
-			if err != nil {
-				return nil, diag.Errorf("invalid DNS_UPDATE_RETRIES environment variable: %s", err.Error())
-			}
-		} else {
-			retries = defaultRetries
-		}
-		if len(os.Getenv("DNS_UPDATE_RECURSIVE")) > 0 {
-			var err error
-			envStr := os.Getenv("DNS_UPDATE_RECURSIVE")
-			recursive, err = strconv.ParseBool(envStr)
-			if err != nil {
-				return nil, diag.Errorf("invalid DNS_UPDATE_RECURSIVE environment variable: %s", err.Error())
-			}
-		} else {
-			recursive = false
-		}
-		if len(os.Getenv("DNS_UPDATE_KEYNAME")) > 0 {
-			keyname = os.Getenv("DNS_UPDATE_KEYNAME")
-		}
-		if len(os.Getenv("DNS_UPDATE_KEYALGORITHM")) > 0 {
-			keyalgo = os.Getenv("DNS_UPDATE_KEYALGORITHM")
-		}
-		if len(os.Getenv("DNS_UPDATE_KEYSECRET")) > 0 {
-			keysecret = os.Getenv("DNS_UPDATE_KEYSECRET")
-		}
-		if len(os.Getenv("DNS_UPDATE_REALM")) > 0 {
-			realm = os.Getenv("DNS_UPDATE_REALM")
-		}
-		if len(os.Getenv("DNS_UPDATE_USERNAME")) > 0 {
-			username = os.Getenv("DNS_UPDATE_USERNAME")
-		}
-		if len(os.Getenv("DNS_UPDATE_PASSWORD")) > 0 {
-			password = os.Getenv("DNS_UPDATE_PASSWORD")
-		}
-		if len(os.Getenv("DNS_UPDATE_KEYTAB")) > 0 {
-			keytab = os.Getenv("DNS_UPDATE_KEYTAB")
-		}
-		if realm != "" || username != "" || password != "" || keytab != "" {
-			gssapi = true
-		}
-	}
-
-	if timeout != "" {
-		var err error
-		// Try parsing as a duration
-		duration, err = time.ParseDuration(timeout)
-		if err != nil {
-			// Failing that, convert to an integer and treat as seconds
-			seconds, err := strconv.Atoi(timeout)
-			if err != nil {
-				return nil, diag.Errorf("invalid timeout: %s", timeout)
-			}
-			duration = time.Duration(seconds) * time.Second
-		}
-		if duration < 0 {
-			return nil, diag.Errorf("timeout cannot be negative: %s", duration)
-		}
-	}
-
-	config := Config{
-		server:    server,
-		port:      port,
-		transport: transport,
-		timeout:   duration,
-		retries:   retries,
-		keyname:   keyname,
-		keyalgo:   keyalgo,
-		keysecret: keysecret,
-		gssapi:    gssapi,
-		realm:     realm,
-		username:  username,
-		password:  password,
-		keytab:    keytab,
-		recursive: recursive,
-	}
-
-	dnsClient, err := config.Client(ctx)
-	if err != nil {
-		return dnsClient, diag.Errorf("%s", err.Error())
-	}
-
-	return dnsClient, nil
-}
-
-func getAVal(record interface{}) (string, int, error) {
-
-	_, ok := record.(*dns.A)
-	if !ok {
-		return "", 0, fmt.Errorf("didn't get a A record")
-	}
-
-	//nolint:forcetypeassert
-	recstr := record.(*dns.A).String()
-	var name, class, typ, addr string
-	var ttl int
-
-	_, err := fmt.Sscanf(recstr, "%s\t%d\t%s\t%s\t%s", &name, &ttl, &class, &typ, &addr)
-	if err != nil {
-		return "", 0, fmt.Errorf("Error parsing record: %s", err)
-	}
-
-	return addr, ttl, nil
-}
-
-func getNSVal(record interface{}) (string, int, error) {
-
-	_, ok := record.(*dns.NS)
-	if !ok {
-		return "", 0, fmt.Errorf("didn't get a NS record")
-	}
-
-	//nolint:forcetypeassert
-	recstr := record.(*dns.NS).String()
-	var name, class, typ, nameserver string
-	var ttl int
-
-	_, err := fmt.Sscanf(recstr, "%s\t%d\t%s\t%s\t%s", &name, &ttl, &class, &typ, &nameserver)
-	if err != nil {
-		return "", 0, fmt.Errorf("Error parsing record: %s", err)
-	}
-
-	return nameserver, ttl, nil
-}
-
-func getAAAAVal(record interface{}) (string, int, error) {
-
-	_, ok := record.(*dns.AAAA)
-	if !ok {
-		return "", 0, fmt.Errorf("didn't get a AAAA record")
-	}
-
-	//nolint:forcetypeassert
-	recstr := record.(*dns.AAAA).String()
-	var name, class, typ, addr string
-	var ttl int
-
-	_, err := fmt.Sscanf(recstr, "%s\t%d\t%s\t%s\t%s", &name, &ttl, &class, &typ, &addr)
-	if err != nil {
-		return "", 0, fmt.Errorf("Error parsing record: %s", err)
-	}
-
-	return addr, ttl, nil
-}
-
-func getCnameVal(record interface{}) (string, int, error) {
-
-	_, ok := record.(*dns.CNAME)
-	if !ok {
-		return "", 0, fmt.Errorf("didn't get a CNAME record")
-	}
-
-	//nolint:forcetypeassert
-	recstr := record.(*dns.CNAME).String()
-	var name, class, typ, cname string
-	var ttl int
-
-	_, err := fmt.Sscanf(recstr, "%s\t%d\t%s\t%s\t%s", &name, &ttl, &class, &typ, &cname)
-	if err != nil {
-		return "", 0, fmt.Errorf("Error parsing record: %s", err)
-	}
-
-	return cname, ttl, nil
-}
-
-func getPtrVal(record interface{}) (string, int, error) {
-
-	_, ok := record.(*dns.PTR)
-	if !ok {
-		return "", 0, fmt.Errorf("didn't get a PTR record")
-	}
-
-	//nolint:forcetypeassert
-	recstr := record.(*dns.PTR).String()
-	var name, class, typ, ptr string
-	var ttl int
-
-	_, err := fmt.Sscanf(recstr, "%s\t%d\t%s\t%s\t%s", &name, &ttl, &class, &typ, &ptr)
-	if err != nil {
-		return "", 0, fmt.Errorf("Error parsing record: %s", err)
-	}
-
-	return ptr, ttl, nil
-}
+// Values of the cname_conflicts provider setting.
+const (
+	cnameConflictsWarn   = "warn"
+	cnameConflictsError  = "error"
+	cnameConflictsIgnore = "ignore"
+)
 
 func isTimeout(err error) bool {
 
@@ -472,6 +40,10 @@ func isTimeout(err error) bool {
 
 func exchange(msg *dns.Msg, tsig bool, client *DNSClient) (*dns.Msg, error) {
 
+	if err := msgToASCII(msg); err != nil {
+		return nil, err
+	}
+
 	c := client.c
 	srv_addr := client.srv_addr
 	keyname := client.keyname
@@ -483,25 +55,9 @@ func exchange(msg *dns.Msg, tsig bool, client *DNSClient) (*dns.Msg, error) {
 
 	// GSS-TSIG
 	if tsig && g != nil {
-		realm := client.realm
-		username := client.username
-		password := client.password
-		keytab := client.keytab
-
-		var k string
-		var err error
-
-		if realm != "" && username != "" && (password != "" || keytab != "") {
-			if password != "" {
-				k, _, err = g.NegotiateContextWithCredentials(srv_addr, realm, username, password)
-			} else {
-				k, _, err = g.NegotiateContextWithKeytab(srv_addr, realm, username, keytab)
-			}
-		} else {
-			k, _, err = g.NegotiateContext(srv_addr)
-		}
+		k, err := negotiateContext(client)
 		if err != nil {
-			return nil, fmt.Errorf("error negotiating GSS context: %s", err)
+			return nil, err
 		}
 
 		//nolint:errcheck
@@ -562,169 +118,228 @@ func exchange(msg *dns.Msg, tsig bool, client *DNSClient) (*dns.Msg, error) {
 	return nil, fmt.Errorf("unable to complete DNS exchange")
 }
 
-func resourceDnsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+// negotiateContext negotiates a GSS-TSIG context with the DNS server of the
+// client and returns the name of its key.
+func negotiateContext(client *DNSClient) (string, error) {
+	g := client.gssClient
+	srv_addr := client.srv_addr
+	realm := client.realm
+	username := client.username
+	password := client.password
+	keytab := client.keytab
 
-	record := d.Id()
-	if !dns.IsFqdn(record) {
-		return nil, fmt.Errorf("Not a fully-qualified DNS name: %s", record)
+	var k string
+	var err error
+
+	if realm != "" && username != "" && (password != "" || keytab != "") {
+		if password != "" {
+			k, _, err = g.NegotiateContextWithCredentials(srv_addr, realm, username, password)
+		} else {
+			k, _, err = g.NegotiateContextWithKeytab(srv_addr, realm, username, keytab)
+		}
+	} else {
+		k, _, err = g.NegotiateContext(srv_addr)
+	}
+	if err != nil {
+		return "", fmt.Errorf("error negotiating GSS context: %s", err)
 	}
 
-	labels := dns.SplitDomainName(record)
+	return k, nil
+}
 
+// transfer returns the records of zone, transferred with AXFR from the DNS
+// server of the client. The transfer is signed like the updates, and always
+// uses TCP.
+func transfer(zone string, client *DNSClient) ([]dns.RR, error) {
 	msg := new(dns.Msg)
+	msg.SetAxfr(zone)
 
-	var zone *string
-
-Loop:
-	for l := range labels {
-
-		msg.SetQuestion(dns.Fqdn(strings.Join(labels[l:], ".")), dns.TypeSOA)
-
-		dnsClient, ok := meta.(*DNSClient)
-		if !ok {
-			return nil, fmt.Errorf("Error asserting meta to *DNSClient")
-		}
+	if err := msgToASCII(msg); err != nil {
+		return nil, err
+	}
 
-		r, err := exchange(msg, true, dnsClient)
+	keyname := client.keyname
+	if g := client.gssClient; g != nil {
+		k, err := negotiateContext(client)
 		if err != nil {
-			return nil, fmt.Errorf("Error querying DNS record: %s", err)
+			return nil, err
 		}
 
-		switch r.Rcode {
-		case dns.RcodeSuccess:
-
-			if len(r.Answer) == 0 {
-				continue
-			}
-
-			for _, ans := range r.Answer {
-				switch t := ans.(type) {
-				case *dns.SOA:
-					zone = &t.Hdr.Name
-				case *dns.CNAME:
-					continue Loop
-				}
-			}
+		//nolint:errcheck
+		defer g.DeleteContext(k)
 
-			break Loop
-		case dns.RcodeNameError:
-			continue
-		default:
-			return nil, fmt.Errorf("Error querying DNS record: %v (%s)", r.Rcode, dns.RcodeToString[r.Rcode])
-		}
+		keyname = k
 	}
 
-	if zone == nil {
-		return nil, fmt.Errorf("No SOA record in authority section in response for %s", record)
+	if keyname != "" {
+		msg.SetTsig(keyname, client.keyalgo, 300, time.Now().Unix())
 	}
 
-	common := dns.CompareDomainName(record, *zone)
-	if common == 0 {
-		return nil, fmt.Errorf("DNS record %s shares no common labels with zone %s", record, *zone)
+	network := "tcp"
+	switch client.transport {
+	case "udp4", "tcp4":
+		network = "tcp4"
+	case "udp6", "tcp6":
+		network = "tcp6"
 	}
 
-	//nolint:errcheck
-	d.Set("zone", *zone)
-	if name := strings.Join(labels[:len(labels)-common], "."); name != "" {
-		//nolint:errcheck
-		d.Set("name", name)
+	timeout := client.c.Timeout
+	if timeout == 0 {
+		timeout = 2 * time.Second
 	}
 
-	return []*schema.ResourceData{d}, nil
-}
+	conn, err := dns.DialTimeout(network, client.srv_addr, timeout)
+	if err != nil {
+		return nil, err
+	}
+	//nolint:errcheck
+	defer conn.Close()
 
-func resourceFQDN(d *schema.ResourceData) string {
+	t := &dns.Transfer{
+		Conn:         conn,
+		ReadTimeout:  timeout,
+		WriteTimeout: timeout,
+		TsigProvider: client.c.TsigProvider,
+	}
 
-	//nolint:forcetypeassert
-	fqdn := d.Get("zone").(string)
+	log.Printf("[DEBUG] Sending DNS message to server (%s):\n%s", client.srv_addr, msg)
 
-	if name, ok := d.GetOk("name"); ok {
-		//nolint:forcetypeassert
-		fqdn = fmt.Sprintf("%s.%s", name.(string), fqdn)
+	env, err := t.In(msg, client.srv_addr)
+	if err != nil {
+		return nil, err
 	}
 
-	return fqdn
-}
+	var records []dns.RR
+	for e := range env {
+		if e.Error != nil {
+			return nil, e.Error
+		}
+		records = append(records, e.RR...)
+	}
 
-func resourceDnsRead(d *schema.ResourceData, meta interface{}, rrType uint16) ([]dns.RR, diag.Diagnostics) {
+	log.Printf("[DEBUG] Received %d records in zone transfer of %s from server (%s)", len(records), zone, client.srv_addr)
 
-	if meta != nil {
+	return records, nil
+}
 
-		fqdn := resourceFQDN(d)
+// readZoneFile returns the records of the zone file of zone read from r.
+// Relative $INCLUDE directives are resolved from the directory of path, or
+// from the working directory if path is empty.
+func readZoneFile(r io.Reader, zone, path string) ([]dns.RR, error) {
+	origin, err := idn.ToASCII(zone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid internationalized domain name %q: %w", zone, err)
+	}
 
-		msg := new(dns.Msg)
-		msg.SetQuestion(fqdn, rrType)
+	zp := dns.NewZoneParser(r, origin, path)
+	zp.SetIncludeAllowed(true)
 
-		dnsClient, ok := meta.(*DNSClient)
-		if !ok {
-			return nil, diag.Errorf("Error asserting meta to *DNSClient")
-		}
+	var records []dns.RR
+	for rr, ok := zp.Next(); ok; rr, ok = zp.Next() {
+		records = append(records, rr)
+	}
+	if err := zp.Err(); err != nil {
+		return nil, err
+	}
 
-		// Set recursion desired flag
-		msg.RecursionDesired = dnsClient.recursive
+	return records, nil
+}
 
-		r, err := exchange(msg, true, dnsClient)
+// msgToASCII converts the domain names in msg to their ASCII form, so
+// internationalized names can be configured in their Unicode form.
+func msgToASCII(msg *dns.Msg) error {
+	for i, q := range msg.Question {
+		name, err := idn.ToASCII(q.Name)
 		if err != nil {
-			return nil, diag.Errorf("Error querying DNS record: %s", err)
-		}
-		switch r.Rcode {
-		case dns.RcodeSuccess:
-			// NS records are returned slightly differently
-			if (rrType == dns.TypeNS && len(r.Ns) > 0) || len(r.Answer) > 0 {
-				break
-			}
-			fallthrough
-		case dns.RcodeNameError:
-			return nil, nil
-		default:
-			return nil, diag.Errorf("Error querying DNS record: %v (%s)", r.Rcode, dns.RcodeToString[r.Rcode])
+			return fmt.Errorf("invalid internationalized domain name %q: %w", q.Name, err)
 		}
+		msg.Question[i].Name = name
+	}
 
-		if rrType == dns.TypeNS {
-			return r.Ns, nil
+	for _, section := range [][]dns.RR{msg.Answer, msg.Ns, msg.Extra} {
+		for _, record := range section {
+			if err := rdata.ToASCII(record); err != nil {
+				return err
+			}
 		}
-		return r.Answer, nil
-	} else {
-		return nil, diag.Errorf("update server is not set")
 	}
+
+	return nil
 }
 
-func resourceDnsDelete(d *schema.ResourceData, meta interface{}, rrType uint16) diag.Diagnostics {
+// resourceDnsDiscover finds the zone enclosing record by walking up its labels
+// looking for an SOA record, and returns the zone along with the name of the
+// record relative to it. Results are cached on the client.
+func resourceDnsDiscover(record string, client *DNSClient) (string, string, error) {
 
-	if meta != nil {
+	if !dns.IsFqdn(record) {
+		return "", "", fmt.Errorf("Not a fully-qualified DNS name: %s", record)
+	}
 
-		fqdn := resourceFQDN(d)
+	labels := dns.SplitDomainName(record)
 
-		//nolint:forcetypeassert
+	zone, ok := client.zones.get(record)
+	if !ok {
 		msg := new(dns.Msg)
 
-		//nolint:forcetypeassert
-		msg.SetUpdate(d.Get("zone").(string))
+	Loop:
+		for l := range labels {
 
-		rrStr := fmt.Sprintf("%s 0 %s", fqdn, dns.TypeToString[rrType])
+			msg.SetQuestion(dns.Fqdn(strings.Join(labels[l:], ".")), dns.TypeSOA)
 
-		rr, err := dns.NewRR(rrStr)
-		if err != nil {
-			return diag.Errorf("error reading DNS record (%s): %s", rrStr, err)
-		}
+			r, err := exchange(msg, true, client)
+			if err != nil {
+				return "", "", fmt.Errorf("Error querying DNS record: %s", err)
+			}
 
-		msg.RemoveRRset([]dns.RR{rr})
+			switch r.Rcode {
+			case dns.RcodeSuccess:
 
-		dnsClient, ok := meta.(*DNSClient)
-		if !ok {
-			diag.Errorf("Error asserting meta to *DNSClient")
-		}
+				if len(r.Answer) == 0 {
+					continue
+				}
 
-		r, err := exchange(msg, true, dnsClient)
-		if err != nil {
-			return diag.Errorf("Error deleting DNS record: %s", err)
+				for _, ans := range r.Answer {
+					switch t := ans.(type) {
+					case *dns.SOA:
+						zone = t.Hdr.Name
+					case *dns.CNAME:
+						continue Loop
+					}
+				}
+
+				break Loop
+			case dns.RcodeNameError:
+				continue
+			default:
+				return "", "", fmt.Errorf("Error querying DNS record: %v (%s)", r.Rcode, dns.RcodeToString[r.Rcode])
+			}
 		}
-		if r.Rcode != dns.RcodeSuccess {
-			return diag.Errorf("Error deleting DNS record: %v (%s)", r.Rcode, dns.RcodeToString[r.Rcode])
+
+		if zone == "" {
+			return "", "", fmt.Errorf("No SOA record in authority section in response for %s", record)
 		}
 
-		return nil
-	} else {
-		return diag.Errorf("update server is not set")
+		client.zones.set(record, zone)
+	}
+
+	ascii, err := idn.ToASCII(record)
+	if err != nil {
+		return "", "", fmt.Errorf("Invalid internationalized domain name %s: %s", record, err)
 	}
+
+	common := dns.CompareDomainName(ascii, zone)
+	if common == 0 {
+		return "", "", fmt.Errorf("DNS record %s shares no common labels with zone %s", record, zone)
+	}
+
+	// The server returns internationalized zones in their ASCII form, keep
+	// the form the record was given in unless the labels no longer line up
+	if asciiLabels := dns.SplitDomainName(ascii); len(asciiLabels) != len(labels) {
+		labels = asciiLabels
+	} else if ascii != record {
+		zone = dns.Fqdn(strings.Join(labels[len(labels)-common:], "."))
+	}
+
+	return zone, strings.Join(labels[:len(labels)-common], "."), nil
 }
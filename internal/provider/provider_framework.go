@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -16,16 +17,31 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/dnstypes"
+	"github.com/hashicorp/terraform-provider-dns/internal/idn"
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
 )
 
-var _ provider.Provider = (*dnsProvider)(nil)
+var (
+	_ provider.Provider                       = (*dnsProvider)(nil)
+	_ provider.ProviderWithFunctions          = (*dnsProvider)(nil)
+	_ provider.ProviderWithEphemeralResources = (*dnsProvider)(nil)
+	_ provider.ProviderWithListResources      = (*dnsProvider)(nil)
+)
 
 func NewFrameworkProvider() provider.Provider {
 	return &dnsProvider{}
@@ -39,6 +55,18 @@ func (p *dnsProvider) Metadata(ctx context.Context, req provider.MetadataRequest
 
 func (p *dnsProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cname_conflicts": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(cnameConflictsWarn, cnameConflictsError, cnameConflictsIgnore),
+				},
+				Description: "How to handle a `dns_cname_record` planned at a name that already has other " +
+					"records, or another record planned at a name that already has a CNAME record, as RFC 1034 " +
+					"does not allow a CNAME record to coexist with other data. Valid values are `warn`, `error` " +
+					"or `ignore`. Defaults to `warn`. Value can also be sourced from the DNS_CNAME_CONFLICTS environment variable.",
+			},
+		},
 		Blocks: map[string]schema.Block{
 			"update": schema.ListNestedBlock{
 				Description: "When the provider is used for DNS updates, this block is required. Only one `update` block may be in the configuration.",
@@ -83,7 +111,6 @@ func (p *dnsProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("gssapi")),
 								stringvalidator.AlsoRequires(
 									path.MatchRelative().AtParent().AtName("key_algorithm"),
-									path.MatchRelative().AtParent().AtName("key_secret"),
 								),
 							},
 							Description: "The name of the TSIG key used to sign the DNS update messages. " +
@@ -95,7 +122,6 @@ func (p *dnsProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("gssapi")),
 								stringvalidator.AlsoRequires(
 									path.MatchRelative().AtParent().AtName("key_name"),
-									path.MatchRelative().AtParent().AtName("key_secret"),
 								),
 							},
 							Description: "Required if `key_name` is set. When using TSIG authentication, the " +
@@ -103,16 +129,38 @@ func (p *dnsProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 								"or `hmac-sha512`. Value can also be sourced from the DNS_UPDATE_KEYALGORITHM environment variable.",
 						},
 						"key_secret": schema.StringAttribute{
+							Optional:  true,
+							Sensitive: true,
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(
+									path.MatchRelative().AtParent().AtName("gssapi"),
+									path.MatchRelative().AtParent().AtName("key_secret_file"),
+								),
+								stringvalidator.AlsoRequires(
+									path.MatchRelative().AtParent().AtName("key_name"),
+									path.MatchRelative().AtParent().AtName("key_algorithm"),
+								),
+							},
+							Description: "This or `key_secret_file` is required if `key_name` is set. A " +
+								"Base64-encoded string containing the shared secret to be used for TSIG. It can be " +
+								"set from an ephemeral value, such as the `dns_tsig_key` ephemeral resource, to keep " +
+								"it out of the plan. Value can also be sourced from the DNS_UPDATE_KEYSECRET environment variable.",
+						},
+						"key_secret_file": schema.StringAttribute{
 							Optional: true,
 							Validators: []validator.String{
-								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("gssapi")),
+								stringvalidator.ConflictsWith(
+									path.MatchRelative().AtParent().AtName("gssapi"),
+									path.MatchRelative().AtParent().AtName("key_secret"),
+								),
 								stringvalidator.AlsoRequires(
 									path.MatchRelative().AtParent().AtName("key_name"),
 									path.MatchRelative().AtParent().AtName("key_algorithm"),
 								),
 							},
-							Description: "Required if `key_name` is set\nA Base64-encoded string containing the " +
-								"shared secret to be used for TSIG. Value can also be sourced from the DNS_UPDATE_KEYSECRET environment variable.",
+							Description: "This or `key_secret` is required if `key_name` is set. The path to a file " +
+								"containing the Base64-encoded shared secret to be used for TSIG, surrounding " +
+								"whitespace is ignored. Value can also be sourced from the DNS_UPDATE_KEYSECRET_FILE environment variable.",
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -122,11 +170,12 @@ func (p *dnsProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 								listvalidator.ConflictsWith(
 									path.MatchRelative().AtParent().AtName("key_name"),
 									path.MatchRelative().AtParent().AtName("key_algorithm"),
-									path.MatchRelative().AtParent().AtName("key_algorithm"),
+									path.MatchRelative().AtParent().AtName("key_secret"),
+									path.MatchRelative().AtParent().AtName("key_secret_file"),
 								),
 							},
 							Description: "A `gssapi` block. Only one `gssapi` block may be in the configuration. " +
-								"Conflicts with use of `key_name`, `key_algorithm` and `key_secret`.",
+								"Conflicts with use of `key_name`, `key_algorithm`, `key_secret` and `key_secret_file`.",
 							NestedObject: schema.NestedBlockObject{
 								Attributes: map[string]schema.Attribute{
 									"realm": schema.StringAttribute{
@@ -141,20 +190,40 @@ func (p *dnsProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 									"password": schema.StringAttribute{
 										Optional: true,
 										Validators: []validator.String{
-											stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("keytab")),
+											stringvalidator.ConflictsWith(
+												path.MatchRelative().AtParent().AtName("keytab"),
+												path.MatchRelative().AtParent().AtName("password_file"),
+											),
 											stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("username")),
 										},
 										Sensitive: true,
-										Description: "This or `keytab` is required if `username` is set. The matching " +
-											"password for `username`. Value can also be sourced from the DNS_UPDATE_PASSWORD environment variable.",
+										Description: "This, `password_file` or `keytab` is required if `username` is set. " +
+											"The matching password for `username`. It can be set from an ephemeral value " +
+											"to keep it out of the plan. Value can also be sourced from the DNS_UPDATE_PASSWORD environment variable.",
+									},
+									"password_file": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.ConflictsWith(
+												path.MatchRelative().AtParent().AtName("keytab"),
+												path.MatchRelative().AtParent().AtName("password"),
+											),
+											stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("username")),
+										},
+										Description: "This, `password` or `keytab` is required if `username` is set. The " +
+											"path to a file containing the matching password for `username`, a trailing " +
+											"newline is ignored. Value can also be sourced from the DNS_UPDATE_PASSWORD_FILE environment variable.",
 									},
 									"keytab": schema.StringAttribute{
 										Optional: true,
 										Validators: []validator.String{
-											stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("password")),
+											stringvalidator.ConflictsWith(
+												path.MatchRelative().AtParent().AtName("password"),
+												path.MatchRelative().AtParent().AtName("password_file"),
+											),
 											stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("username")),
 										},
-										Description: "This or `password` is required if `username` is set, not " +
+										Description: "This, `password` or `password_file` is required if `username` is set, not " +
 											"supported on Windows. The path to a keytab file containing a key for " +
 											"`username`. Value can also be sourced from the DNS_UPDATE_KEYTAB environment variable.",
 									},
@@ -171,7 +240,7 @@ func (p *dnsProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 func (p *dnsProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var providerConfig providerModel
 
-	var server, transport, timeout, keyname, keyalgo, keysecret, realm, username, password, keytab string
+	var server, transport, timeout, keyname, keyalgo, keysecret, realm, username, password, keytab, cnameConflicts string
 	var port, retries int
 	var duration time.Duration
 	var gssapi, recursive bool
@@ -296,8 +365,25 @@ func (p *dnsProvider) Configure(ctx context.Context, req provider.ConfigureReque
 	if providerUpdateConfig[0].KeyAlgorithm.IsNull() && len(os.Getenv("DNS_UPDATE_KEYALGORITHM")) > 0 {
 		keyalgo = os.Getenv("DNS_UPDATE_KEYALGORITHM")
 	}
-	if providerUpdateConfig[0].KeySecret.IsNull() && len(os.Getenv("DNS_UPDATE_KEYSECRET")) > 0 {
-		keysecret = os.Getenv("DNS_UPDATE_KEYSECRET")
+	if providerUpdateConfig[0].KeySecret.IsNull() {
+		keySecretFile := providerUpdateConfig[0].KeySecretFile.ValueString()
+
+		switch {
+		case keySecretFile != "":
+		case len(os.Getenv("DNS_UPDATE_KEYSECRET")) > 0:
+			keysecret = os.Getenv("DNS_UPDATE_KEYSECRET")
+		case len(os.Getenv("DNS_UPDATE_KEYSECRET_FILE")) > 0:
+			keySecretFile = os.Getenv("DNS_UPDATE_KEYSECRET_FILE")
+		}
+
+		if keySecretFile != "" {
+			b, err := os.ReadFile(keySecretFile)
+			if err != nil {
+				resp.Diagnostics.AddError("Error reading TSIG key secret file:", err.Error())
+				return
+			}
+			keysecret = strings.TrimSpace(string(b))
+		}
 	}
 
 	if !providerUpdateConfig[0].Gssapi.IsNull() {
@@ -320,8 +406,27 @@ func (p *dnsProvider) Configure(ctx context.Context, req provider.ConfigureReque
 	if providerGssapiConfig[0].Username.IsNull() && len(os.Getenv("DNS_UPDATE_USERNAME")) > 0 {
 		username = os.Getenv("DNS_UPDATE_USERNAME")
 	}
-	if providerGssapiConfig[0].Password.IsNull() && len(os.Getenv("DNS_UPDATE_PASSWORD")) > 0 {
-		password = os.Getenv("DNS_UPDATE_PASSWORD")
+	if providerGssapiConfig[0].Password.IsNull() {
+		passwordFile := providerGssapiConfig[0].PasswordFile.ValueString()
+
+		switch {
+		case passwordFile != "":
+		case len(os.Getenv("DNS_UPDATE_PASSWORD")) > 0:
+			password = os.Getenv("DNS_UPDATE_PASSWORD")
+		case len(os.Getenv("DNS_UPDATE_PASSWORD_FILE")) > 0:
+			passwordFile = os.Getenv("DNS_UPDATE_PASSWORD_FILE")
+		}
+
+		// Only the line ending is trimmed, as passwords may start or end
+		// with spaces
+		if passwordFile != "" {
+			b, err := os.ReadFile(passwordFile)
+			if err != nil {
+				resp.Diagnostics.AddError("Error reading GSS-TSIG password file:", err.Error())
+				return
+			}
+			password = strings.TrimSuffix(strings.TrimSuffix(string(b), "\n"), "\r")
+		}
 	}
 	if providerGssapiConfig[0].Keytab.IsNull() && len(os.Getenv("DNS_UPDATE_KEYTAB")) > 0 {
 		keytab = os.Getenv("DNS_UPDATE_KEYTAB")
@@ -330,6 +435,24 @@ func (p *dnsProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		gssapi = true
 	}
 
+	cnameConflicts = providerConfig.CNAMEConflicts.ValueString()
+
+	if providerConfig.CNAMEConflicts.IsNull() {
+		cnameConflicts = cnameConflictsWarn
+
+		if len(os.Getenv("DNS_CNAME_CONFLICTS")) > 0 {
+			cnameConflicts = os.Getenv("DNS_CNAME_CONFLICTS")
+
+			switch cnameConflicts {
+			case cnameConflictsWarn, cnameConflictsError, cnameConflictsIgnore:
+			default:
+				resp.Diagnostics.AddError("Invalid DNS_CNAME_CONFLICTS environment variable:",
+					fmt.Sprintf("expected one of %q, %q or %q, got %q", cnameConflictsWarn, cnameConflictsError, cnameConflictsIgnore, cnameConflicts))
+				return
+			}
+		}
+	}
+
 	config := Config{
 		server:    server,
 		port:      port,
@@ -345,21 +468,39 @@ func (p *dnsProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		username:  username,
 		password:  password,
 		keytab:    keytab,
+
+		cnameConflicts: cnameConflicts,
 	}
 
-	resp.ResourceData, configErr = config.Client(ctx)
+	client, configErr := config.Client(ctx)
 	if configErr != nil {
 		resp.Diagnostics.AddError("Error initializing DNS Client:", configErr.Error())
+		return
 	}
+
+	resp.DataSourceData = client
+	resp.ResourceData = client
+	resp.ListResourceData = client
 }
 
 func (p *dnsProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewDnsARecordSetResource,
+		NewDnsAAAARecordSetResource,
+		NewDnsCDNSKEYRecordSetResource,
+		NewDnsCDSRecordSetResource,
 		NewDnsCNAMERecordResource,
+		NewDnsDNSKEYRecordSetResource,
+		NewDnsDNSSECKeyResource,
+		NewDnsDelegationResource,
+		NewDnsDSRecordSetResource,
 		NewDnsMXRecordSetResource,
+		NewDnsNotifyResource,
 		NewDnsNSRecordSetResource,
 		NewDnsPTRRecordResource,
+		NewDnsSOAResource,
 		NewDnsSRVRecordSetResource,
+		NewDnsTSIGKeyResource,
 		NewDnsTXTRecordSetResource,
 	}
 }
@@ -372,26 +513,68 @@ func (p *dnsProvider) DataSources(ctx context.Context) []func() datasource.DataS
 		NewDnsMXRecordSetDataSource,
 		NewDnsNSRecordSetDataSource,
 		NewDnsPTRRecordSetDataSource,
+		NewDnsSOARecordDataSource,
 		NewDnsSRVRecordSetDataSource,
 		NewDnsTXTRecordSetDataSource,
+		NewDnsZoneExportDataSource,
+		NewDnsZoneFileDataSource,
+	}
+}
+
+func (p *dnsProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewDnsTSIGKeyEphemeralResource,
+	}
+}
+
+func (p *dnsProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewDnsARecordSetListResource,
+		NewDnsAAAARecordSetListResource,
+		NewDnsCDNSKEYRecordSetListResource,
+		NewDnsCDSRecordSetListResource,
+		NewDnsCNAMERecordListResource,
+		NewDnsDNSKEYRecordSetListResource,
+		NewDnsDSRecordSetListResource,
+		NewDnsMXRecordSetListResource,
+		NewDnsNSRecordSetListResource,
+		NewDnsPTRRecordListResource,
+		NewDnsSRVRecordSetListResource,
+		NewDnsTXTRecordSetListResource,
+	}
+}
+
+func (p *dnsProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewCanonicalNameFunction,
+		NewFormatRRFunction,
+		NewFQDNFunction,
+		NewIsSubdomainFunction,
+		NewParseRRFunction,
+		NewReverseNameFunction,
+		NewSplitFQDNFunction,
+		NewToASCIIFunction,
+		NewToUnicodeFunction,
 	}
 }
 
 type providerModel struct {
-	Update types.List `tfsdk:"update"` // providerUpdateModel
+	CNAMEConflicts types.String `tfsdk:"cname_conflicts"`
+	Update         types.List   `tfsdk:"update"` // providerUpdateModel
 }
 
 type providerUpdateModel struct {
-	Server       types.String `tfsdk:"server"`
-	Port         types.Int64  `tfsdk:"port"`
-	Transport    types.String `tfsdk:"transport"`
-	Timeout      types.String `tfsdk:"timeout"`
-	Retries      types.Int64  `tfsdk:"retries"`
-	Recursive    types.Bool   `tfsdk:"recursive"`
-	KeyName      types.String `tfsdk:"key_name"`
-	KeyAlgorithm types.String `tfsdk:"key_algorithm"`
-	KeySecret    types.String `tfsdk:"key_secret"`
-	Gssapi       types.List   `tfsdk:"gssapi"` //providerGssapiModel
+	Server        types.String `tfsdk:"server"`
+	Port          types.Int64  `tfsdk:"port"`
+	Transport     types.String `tfsdk:"transport"`
+	Timeout       types.String `tfsdk:"timeout"`
+	Retries       types.Int64  `tfsdk:"retries"`
+	Recursive     types.Bool   `tfsdk:"recursive"`
+	KeyName       types.String `tfsdk:"key_name"`
+	KeyAlgorithm  types.String `tfsdk:"key_algorithm"`
+	KeySecret     types.String `tfsdk:"key_secret"`
+	KeySecretFile types.String `tfsdk:"key_secret_file"`
+	Gssapi        types.List   `tfsdk:"gssapi"` //providerGssapiModel
 }
 
 func (m providerUpdateModel) objectType() types.ObjectType {
@@ -403,23 +586,25 @@ func (m providerUpdateModel) objectAttributeTypes() map[string]attr.Type {
 		"gssapi": types.ListType{
 			ElemType: providerGssapiModel{}.objectType(),
 		},
-		"key_name":      types.StringType,
-		"key_algorithm": types.StringType,
-		"key_secret":    types.StringType,
-		"port":          types.Int64Type,
-		"server":        types.StringType,
-		"retries":       types.Int64Type,
-		"timeout":       types.StringType,
-		"transport":     types.StringType,
-		"recursive":     types.BoolType,
+		"key_name":        types.StringType,
+		"key_algorithm":   types.StringType,
+		"key_secret":      types.StringType,
+		"key_secret_file": types.StringType,
+		"port":            types.Int64Type,
+		"server":          types.StringType,
+		"retries":         types.Int64Type,
+		"timeout":         types.StringType,
+		"transport":       types.StringType,
+		"recursive":       types.BoolType,
 	}
 }
 
 type providerGssapiModel struct {
-	Realm    types.String `tfsdk:"realm"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
-	Keytab   types.String `tfsdk:"keytab"`
+	Realm        types.String `tfsdk:"realm"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	PasswordFile types.String `tfsdk:"password_file"`
+	Keytab       types.String `tfsdk:"keytab"`
 }
 
 func (m providerGssapiModel) objectType() types.ObjectType {
@@ -428,86 +613,282 @@ func (m providerGssapiModel) objectType() types.ObjectType {
 
 func (m providerGssapiModel) objectAttributeTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"keytab":   types.StringType,
-		"password": types.StringType,
-		"realm":    types.StringType,
-		"username": types.StringType,
+		"keytab":        types.StringType,
+		"password":      types.StringType,
+		"password_file": types.StringType,
+		"realm":         types.StringType,
+		"username":      types.StringType,
 	}
 }
 
-func resourceDnsImport_framework(id string, client *DNSClient) (dnsConfig, diag.Diagnostics) {
+// resourceDnsDiscover_framework finds the zone enclosing the fully qualified
+// record name and returns the zone and relative name as a dnsConfig.
+func resourceDnsDiscover_framework(record string, client *DNSClient) (dnsConfig, diag.Diagnostics) {
 	var config dnsConfig
 	var diags diag.Diagnostics
 
-	record := id
-	if !dns.IsFqdn(record) {
-		diags.AddError("Error importing DNS record:",
-			fmt.Sprintf("Not a fully-qualified DNS name: %s", record))
+	zone, name, err := resourceDnsDiscover(record, client)
+	if err != nil {
+		diags.AddError("Error discovering DNS zone:", err.Error())
 		return config, diags
 	}
 
-	labels := dns.SplitDomainName(record)
+	config.Zone = zone
+	config.Name = name
 
-	msg := new(dns.Msg)
+	return config, nil
+}
+
+// recordIdentityModel is the resource identity of the record resources.
+type recordIdentityModel struct {
+	Zone types.String `tfsdk:"zone"`
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+// recordIdentitySchema returns the identity schema of the record resources,
+// which identifies a record set by its zone, name and type.
+func recordIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"zone": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "DNS zone the record set belongs to. It must be an FQDN, that is, include the trailing dot.",
+			},
+			"name": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The name of the record set, relative to the zone. Unset for the apex of the zone.",
+			},
+			"type": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The type of the records, such as `A`. Must match the type of the resource if set.",
+			},
+		},
+	}
+}
 
-	var zone *string
+// recordIdentity returns the resource identity of the records of rrType at
+// config.
+func recordIdentity(config dnsConfig, rrType uint16) recordIdentityModel {
+	return recordIdentityModel{
+		Zone: types.StringValue(config.Zone),
+		Name: config.nameValue(),
+		Type: types.StringValue(dns.TypeToString[rrType]),
+	}
+}
 
-Loop:
-	for l := range labels {
+// resourceDnsImport_framework returns the ID and the dnsConfig of the record
+// set being imported. The zone of an import ID, the FQDN of the record set,
+// is discovered, while the zone and name of the resource identity in an
+// import block are used as given.
+func resourceDnsImport_framework(ctx context.Context, req resource.ImportStateRequest, rrType uint16, client *DNSClient) (string, dnsConfig, diag.Diagnostics) {
+	if req.ID != "" || req.Identity == nil {
+		config, diags := resourceDnsDiscover_framework(req.ID, client)
+		return req.ID, config, diags
+	}
 
-		msg.SetQuestion(dns.Fqdn(strings.Join(labels[l:], ".")), dns.TypeSOA)
+	config, diags := resourceDnsIdentityConfig_framework(ctx, req.Identity, rrType)
+	if diags.HasError() {
+		return "", config, diags
+	}
 
-		r, err := exchange(msg, true, client)
-		if err != nil {
-			diags.AddError("Error querying DNS record:", err.Error())
-			return config, diags
-		}
+	return resourceFQDN_framework(config), config, diags
+}
+
+// resourceDnsIdentityConfig_framework returns the dnsConfig of the resource
+// identity of the records of rrType.
+func resourceDnsIdentityConfig_framework(ctx context.Context, resourceIdentity *tfsdk.ResourceIdentity, rrType uint16) (dnsConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
-		switch r.Rcode {
-		case dns.RcodeSuccess:
+	var identity recordIdentityModel
+	diags.Append(resourceIdentity.Get(ctx, &identity)...)
+	if diags.HasError() {
+		return dnsConfig{}, diags
+	}
 
-			if len(r.Answer) == 0 {
-				continue
-			}
+	if !dns.IsFqdn(identity.Zone.ValueString()) {
+		diags.AddAttributeError(path.Root("zone"), "Invalid Resource Identity",
+			fmt.Sprintf("The zone %q must be an FQDN, that is, include the trailing dot.", identity.Zone.ValueString()))
+		return dnsConfig{}, diags
+	}
+	if !identity.Type.IsNull() && !strings.EqualFold(identity.Type.ValueString(), dns.TypeToString[rrType]) {
+		diags.AddAttributeError(path.Root("type"), "Invalid Resource Identity",
+			fmt.Sprintf("The type %q does not match the %s records of the resource.", identity.Type.ValueString(), dns.TypeToString[rrType]))
+		return dnsConfig{}, diags
+	}
+
+	return dnsConfig{
+		Name: identity.Name.ValueString(),
+		Zone: identity.Zone.ValueString(),
+	}, diags
+}
+
+// resourceDnsConfig_framework returns the dnsConfig for the zone, name and fqdn
+// attributes of a record resource, discovering the zone from fqdn if it could
+// not be discovered at plan time.
+func resourceDnsConfig_framework(zone, name, fqdn types.String, client *DNSClient) (dnsConfig, diag.Diagnostics) {
+	if zone.IsUnknown() {
+		return resourceDnsDiscover_framework(fqdn.ValueString(), client)
+	}
+
+	return dnsConfig{
+		Name: name.ValueString(),
+		Zone: zone.ValueString(),
+	}, nil
+}
 
-			for _, ans := range r.Answer {
-				switch t := ans.(type) {
-				case *dns.SOA:
-					zone = &t.Hdr.Name
-				case *dns.CNAME:
-					continue Loop
-				}
+// resourceDnsModifyPlan_framework keeps the zone, name and fqdn attributes of a
+// record resource consistent with each other. When fqdn is configured the zone
+// is discovered with the provider client, if it is available at plan time.
+// Records of rrType being created are also checked for CNAME conflicts.
+func resourceDnsModifyPlan_framework(ctx context.Context, client *DNSClient, rrType uint16, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configZone, configName, configFQDN types.String
+	var stateZone, stateName, stateFQDN types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("zone"), &configZone)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &configName)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("fqdn"), &configFQDN)...)
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("zone"), &stateZone)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &stateName)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("fqdn"), &stateFQDN)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone := types.StringUnknown()
+	name := types.StringUnknown()
+	fqdn := types.StringUnknown()
+
+	if !configFQDN.IsNull() {
+		fqdn = configFQDN
+
+		switch {
+		case configFQDN.IsUnknown():
+		case configFQDN.Equal(stateFQDN):
+			zone, name = stateZone, stateName
+		case client != nil:
+			config, diags := resourceDnsDiscover_framework(configFQDN.ValueString(), client)
+			resp.Diagnostics.Append(diags...)
+			if diags.HasError() {
+				return
 			}
+			zone, name = types.StringValue(config.Zone), config.nameValue()
+		}
+	} else {
+		zone, name = configZone, configName
 
-			break Loop
-		case dns.RcodeNameError:
-			continue
-		default:
-			diags.AddError(fmt.Sprintf("Error querying DNS record: %v", r.Rcode), dns.RcodeToString[r.Rcode])
-			return config, diags
+		if !zone.IsUnknown() && !name.IsUnknown() {
+			fqdn = types.StringValue(resourceFQDN_framework(dnsConfig{
+				Name: name.ValueString(),
+				Zone: zone.ValueString(),
+			}))
+		}
+
+		// name is also computed, so removing it from the configuration has
+		// to be detected here
+		if !req.State.Raw.IsNull() && !name.IsUnknown() && name.ValueString() != stateName.ValueString() {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("name"))
 		}
 	}
 
-	if zone == nil {
-		diags.AddError("Error querying DNS record:",
-			fmt.Sprintf("No SOA record in authority section in response for %s", record))
-		return config, diags
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("zone"), zone)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("fqdn"), fqdn)...)
+
+	if req.State.Raw.IsNull() && client != nil && !zone.IsUnknown() && !name.IsUnknown() {
+		resp.Diagnostics.Append(resourceDnsCheckCNAMEConflict_framework(dnsConfig{
+			Name: name.ValueString(),
+			Zone: zone.ValueString(),
+		}, client, rrType)...)
 	}
+}
 
-	common := dns.CompareDomainName(record, *zone)
-	if common == 0 {
-		diags.AddError("Error querying DNS record:",
-			fmt.Sprintf("DNS record %s shares no common labels with zone %s", record, *zone))
-		return config, diags
+// cnameConflictTypes are the record types a CNAME record is checked against.
+var cnameConflictTypes = []uint16{
+	dns.TypeA,
+	dns.TypeAAAA,
+	dns.TypeCDNSKEY,
+	dns.TypeCDS,
+	dns.TypeDNSKEY,
+	dns.TypeDS,
+	dns.TypeMX,
+	dns.TypeNS,
+	dns.TypePTR,
+	dns.TypeSRV,
+	dns.TypeTXT,
+}
+
+// resourceDnsCheckCNAMEConflict_framework looks for existing records that
+// cannot coexist with a record of rrType at the name, as RFC 1034 does not
+// allow a CNAME record to coexist with other data. Conflicts are reported as
+// warnings or errors depending on the cname_conflicts provider setting.
+func resourceDnsCheckCNAMEConflict_framework(config dnsConfig, client *DNSClient, rrType uint16) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if client.cnameConflicts == cnameConflictsIgnore {
+		return nil
 	}
 
-	config.Zone = *zone
+	fqdn := resourceFQDN_framework(config)
+	owner, err := idn.ToASCII(fqdn)
+	if err != nil {
+		owner = fqdn
+	}
 
-	if name := strings.Join(labels[:len(labels)-common], "."); name != "" {
-		config.Name = name
+	rrTypes := []uint16{dns.TypeCNAME}
+	if rrType == dns.TypeCNAME {
+		rrTypes = cnameConflictTypes
 	}
 
-	return config, nil
+	var conflicts []string
+	for _, t := range rrTypes {
+		records, readDiags := resourceDnsRead_framework(config, client, t)
+		if readDiags.HasError() {
+			diags.AddWarning("Unable to check for CNAME conflicts:",
+				fmt.Sprintf("Querying %s records of %s failed: %s", dns.TypeToString[t], fqdn, readDiags[0].Detail()))
+			return diags
+		}
+
+		// Queries may also return records of other names or types, such as
+		// the target of a CNAME record or the SOA record of the zone
+		for _, record := range records {
+			if record.Header().Rrtype == t && dns.CanonicalName(record.Header().Name) == dns.CanonicalName(owner) {
+				conflicts = append(conflicts, dns.TypeToString[t])
+				break
+			}
+		}
+	}
+
+	if len(conflicts) == 0 {
+		return nil
+	}
+
+	var detail string
+	if rrType == dns.TypeCNAME {
+		detail = fmt.Sprintf("%s already has %s records. ", fqdn, strings.Join(conflicts, ", "))
+	} else {
+		detail = fmt.Sprintf("%s already has a CNAME record. ", fqdn)
+	}
+	detail += "A CNAME record cannot coexist with other data at the same name, so the DNS server " +
+		"may reject the update or the records may resolve inconsistently. " +
+		"This check can be configured with the cname_conflicts provider setting."
+
+	if client.cnameConflicts == cnameConflictsError {
+		diags.AddError("CNAME conflict:", detail)
+	} else {
+		diags.AddWarning("CNAME conflict:", detail)
+	}
+
+	return diags
 }
 
 func resourceFQDN_framework(config dnsConfig) string {
@@ -563,15 +944,7 @@ func resourceDnsDelete_framework(config dnsConfig, client *DNSClient, rrType uin
 
 	msg.SetUpdate(config.Zone)
 
-	rrStr := fmt.Sprintf("%s 0 %s", fqdn, dns.TypeToString[rrType])
-
-	rr, err := dns.NewRR(rrStr)
-	if err != nil {
-		diags.AddError(fmt.Sprintf("Error reading DNS record (%s):", rrStr), err.Error())
-		return diags
-	}
-
-	msg.RemoveRRset([]dns.RR{rr})
+	msg.RemoveRRset([]dns.RR{rdata.NewEmpty(fqdn, rrType)})
 
 	r, err := exchange(msg, true, client)
 	if err != nil {
@@ -590,3 +963,123 @@ type dnsConfig struct {
 	Name string
 	Zone string
 }
+
+// nameValue returns the name as an attribute value, which is null for records
+// at the zone apex.
+func (c dnsConfig) nameValue() types.String {
+	if c.Name == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(c.Name)
+}
+
+// resourceDnsAddressRecordSetSchemaV0 returns the schema of the A and AAAA
+// record set resources as they were implemented with the SDKv2.
+func resourceDnsAddressRecordSetSchemaV0() rschema.Schema {
+	return rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"zone": rschema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"name": rschema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"fqdn": rschema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"addresses": rschema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"ttl": rschema.Int64Attribute{
+				Optional: true,
+			},
+			"id": rschema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type addressRecordSetResourceModelV0 struct {
+	ID        types.String `tfsdk:"id"`
+	Zone      types.String `tfsdk:"zone"`
+	Name      types.String `tfsdk:"name"`
+	FQDN      types.String `tfsdk:"fqdn"`
+	Addresses types.Set    `tfsdk:"addresses"`
+	TTL       types.Int64  `tfsdk:"ttl"`
+}
+
+// upgrade returns the state in the current format. The SDKv2 stored an empty
+// name for records at the zone apex and had no fqdn attribute in older
+// versions, the addresses are kept as they were written.
+func (m addressRecordSetResourceModelV0) upgrade(ctx context.Context) (addressRecordSetResourceModelV0, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if m.Name.ValueString() == "" {
+		m.Name = types.StringNull()
+	}
+
+	config := dnsConfig{
+		Name: m.Name.ValueString(),
+		Zone: m.Zone.ValueString(),
+	}
+	m.FQDN = types.StringValue(resourceFQDN_framework(config))
+	m.ID = types.StringValue(resourceFQDN_framework(config))
+
+	if m.TTL.IsNull() {
+		m.TTL = types.Int64Value(3600)
+	}
+
+	var addresses []string
+	diags.Append(m.Addresses.ElementsAs(ctx, &addresses, false)...)
+	if diags.HasError() {
+		return m, diags
+	}
+
+	values := make([]dnstypes.IPAddress, 0, len(addresses))
+	for _, addr := range addresses {
+		values = append(values, dnstypes.NewIPAddressValue(addr))
+	}
+
+	var convertDiags diag.Diagnostics
+	m.Addresses, convertDiags = types.SetValueFrom(ctx, dnstypes.IPAddressType{}, values)
+	diags.Append(convertDiags...)
+
+	return m, diags
+}
+
+// addressDifference returns the addresses that have to be added and removed to
+// turn the current addresses into the planned ones. Addresses are compared by
+// value, so a different textual form of the same address is not a change.
+func addressDifference(planned, current []dnstypes.IPAddress) ([]string, []string) {
+	plannedSet := make(map[string]bool)
+	for _, addr := range planned {
+		plannedSet[addr.ValueIP().String()] = true
+	}
+
+	currentSet := make(map[string]bool)
+	for _, addr := range current {
+		currentSet[addr.ValueIP().String()] = true
+	}
+
+	var add, remove []string
+	for addr := range plannedSet {
+		if !currentSet[addr] {
+			add = append(add, addr)
+		}
+	}
+	for addr := range currentSet {
+		if !plannedSet[addr] {
+			remove = append(remove, addr)
+		}
+	}
+	sort.Strings(add)
+	sort.Strings(remove)
+
+	return add, remove
+}
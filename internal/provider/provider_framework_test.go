@@ -5,9 +5,12 @@ package provider
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/bodgit/tsig"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -16,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/miekg/dns"
 )
 
@@ -62,8 +66,10 @@ func TestDnsProviderConfigure(t *testing.T) {
 	t.Setenv("DNS_UPDATE_KEYALGORITHM", "")
 	t.Setenv("DNS_UPDATE_KEYNAME", "")
 	t.Setenv("DNS_UPDATE_KEYSECRET", "")
+	t.Setenv("DNS_UPDATE_KEYSECRET_FILE", "")
 	t.Setenv("DNS_UPDATE_KEYTAB", "")
 	t.Setenv("DNS_UPDATE_PASSWORD", "")
+	t.Setenv("DNS_UPDATE_PASSWORD_FILE", "")
 	t.Setenv("DNS_UPDATE_PORT", "")
 	t.Setenv("DNS_UPDATE_REALM", "")
 	t.Setenv("DNS_UPDATE_RETRIES", "")
@@ -72,6 +78,13 @@ func TestDnsProviderConfigure(t *testing.T) {
 	t.Setenv("DNS_UPDATE_TIMEOUT", "")
 	t.Setenv("DNS_UPDATE_USERNAME", "")
 	t.Setenv("DNS_UPDATE_RECURSIVE", "")
+	t.Setenv("DNS_CNAME_CONFLICTS", "")
+
+	keySecretFile := filepath.Join(t.TempDir(), "tsig-key.secret")
+	if err := os.WriteFile(keySecretFile, []byte("c2VjcmV0\n"), 0o600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	missingFile := filepath.Join(t.TempDir(), "missing")
 
 	testCases := map[string]struct {
 		env      map[string]string
@@ -81,7 +94,8 @@ func TestDnsProviderConfigure(t *testing.T) {
 		"no-config-or-env": {
 			request: provider.ConfigureRequest{
 				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
-					"update": types.ListNull(providerUpdateModel{}.objectType()),
+					"cname_conflicts": types.StringNull(),
+					"update":          types.ListNull(providerUpdateModel{}.objectType()),
 				}),
 			},
 			expected: &provider.ConfigureResponse{
@@ -89,31 +103,34 @@ func TestDnsProviderConfigure(t *testing.T) {
 					c: &dns.Client{
 						Net: "udp",
 					},
-					retries:   3,
-					srv_addr:  ":53",
-					transport: "udp",
+					retries:        3,
+					srv_addr:       ":53",
+					cnameConflicts: "warn",
+					transport:      "udp",
 				},
 			},
 		},
 		"update-port-config": {
 			request: provider.ConfigureRequest{
 				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
+					"cname_conflicts": types.StringNull(),
 					"update": types.ListValueMust(
 						providerUpdateModel{}.objectType(),
 						[]attr.Value{
 							types.ObjectValueMust(
 								providerUpdateModel{}.objectAttributeTypes(),
 								map[string]attr.Value{
-									"gssapi":        types.ListNull(providerGssapiModel{}.objectType()),
-									"key_name":      types.StringNull(),
-									"key_algorithm": types.StringNull(),
-									"key_secret":    types.StringNull(),
-									"port":          types.Int64Value(1053),
-									"server":        types.StringNull(),
-									"retries":       types.Int64Null(),
-									"timeout":       types.StringNull(),
-									"transport":     types.StringNull(),
-									"recursive":     types.BoolNull(),
+									"gssapi":          types.ListNull(providerGssapiModel{}.objectType()),
+									"key_name":        types.StringNull(),
+									"key_algorithm":   types.StringNull(),
+									"key_secret":      types.StringNull(),
+									"key_secret_file": types.StringNull(),
+									"port":            types.Int64Value(1053),
+									"server":          types.StringNull(),
+									"retries":         types.Int64Null(),
+									"timeout":         types.StringNull(),
+									"transport":       types.StringNull(),
+									"recursive":       types.BoolNull(),
 								},
 							),
 						},
@@ -125,9 +142,10 @@ func TestDnsProviderConfigure(t *testing.T) {
 					c: &dns.Client{
 						Net: "udp",
 					},
-					retries:   3,
-					srv_addr:  ":1053",
-					transport: "udp",
+					retries:        3,
+					srv_addr:       ":1053",
+					cnameConflicts: "warn",
+					transport:      "udp",
 				},
 			},
 		},
@@ -137,22 +155,24 @@ func TestDnsProviderConfigure(t *testing.T) {
 			},
 			request: provider.ConfigureRequest{
 				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
+					"cname_conflicts": types.StringNull(),
 					"update": types.ListValueMust(
 						providerUpdateModel{}.objectType(),
 						[]attr.Value{
 							types.ObjectValueMust(
 								providerUpdateModel{}.objectAttributeTypes(),
 								map[string]attr.Value{
-									"gssapi":        types.ListNull(providerGssapiModel{}.objectType()),
-									"key_name":      types.StringNull(),
-									"key_algorithm": types.StringNull(),
-									"key_secret":    types.StringNull(),
-									"port":          types.Int64Value(1053),
-									"server":        types.StringNull(),
-									"retries":       types.Int64Null(),
-									"timeout":       types.StringNull(),
-									"transport":     types.StringNull(),
-									"recursive":     types.BoolNull(),
+									"gssapi":          types.ListNull(providerGssapiModel{}.objectType()),
+									"key_name":        types.StringNull(),
+									"key_algorithm":   types.StringNull(),
+									"key_secret":      types.StringNull(),
+									"key_secret_file": types.StringNull(),
+									"port":            types.Int64Value(1053),
+									"server":          types.StringNull(),
+									"retries":         types.Int64Null(),
+									"timeout":         types.StringNull(),
+									"transport":       types.StringNull(),
+									"recursive":       types.BoolNull(),
 								},
 							),
 						},
@@ -164,9 +184,10 @@ func TestDnsProviderConfigure(t *testing.T) {
 					c: &dns.Client{
 						Net: "udp",
 					},
-					retries:   3,
-					srv_addr:  ":1053",
-					transport: "udp",
+					retries:        3,
+					srv_addr:       ":1053",
+					cnameConflicts: "warn",
+					transport:      "udp",
 				},
 			},
 		},
@@ -176,7 +197,8 @@ func TestDnsProviderConfigure(t *testing.T) {
 			},
 			request: provider.ConfigureRequest{
 				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
-					"update": types.ListNull(providerUpdateModel{}.objectType()),
+					"cname_conflicts": types.StringNull(),
+					"update":          types.ListNull(providerUpdateModel{}.objectType()),
 				}),
 			},
 			expected: &provider.ConfigureResponse{
@@ -184,9 +206,10 @@ func TestDnsProviderConfigure(t *testing.T) {
 					c: &dns.Client{
 						Net: "udp",
 					},
-					retries:   3,
-					srv_addr:  ":1053",
-					transport: "udp",
+					retries:        3,
+					srv_addr:       ":1053",
+					cnameConflicts: "warn",
+					transport:      "udp",
 				},
 			},
 		},
@@ -196,7 +219,8 @@ func TestDnsProviderConfigure(t *testing.T) {
 			},
 			request: provider.ConfigureRequest{
 				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
-					"update": types.ListNull(providerUpdateModel{}.objectType()),
+					"cname_conflicts": types.StringNull(),
+					"update":          types.ListNull(providerUpdateModel{}.objectType()),
 				}),
 			},
 			expected: &provider.ConfigureResponse{
@@ -212,22 +236,24 @@ func TestDnsProviderConfigure(t *testing.T) {
 		"update-server-config": {
 			request: provider.ConfigureRequest{
 				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
+					"cname_conflicts": types.StringNull(),
 					"update": types.ListValueMust(
 						providerUpdateModel{}.objectType(),
 						[]attr.Value{
 							types.ObjectValueMust(
 								providerUpdateModel{}.objectAttributeTypes(),
 								map[string]attr.Value{
-									"gssapi":        types.ListNull(providerGssapiModel{}.objectType()),
-									"key_name":      types.StringNull(),
-									"key_algorithm": types.StringNull(),
-									"key_secret":    types.StringNull(),
-									"port":          types.Int64Null(),
-									"server":        types.StringValue("example.com"),
-									"retries":       types.Int64Null(),
-									"timeout":       types.StringNull(),
-									"transport":     types.StringNull(),
-									"recursive":     types.BoolNull(),
+									"gssapi":          types.ListNull(providerGssapiModel{}.objectType()),
+									"key_name":        types.StringNull(),
+									"key_algorithm":   types.StringNull(),
+									"key_secret":      types.StringNull(),
+									"key_secret_file": types.StringNull(),
+									"port":            types.Int64Null(),
+									"server":          types.StringValue("example.com"),
+									"retries":         types.Int64Null(),
+									"timeout":         types.StringNull(),
+									"transport":       types.StringNull(),
+									"recursive":       types.BoolNull(),
 								},
 							),
 						},
@@ -239,9 +265,10 @@ func TestDnsProviderConfigure(t *testing.T) {
 					c: &dns.Client{
 						Net: "udp",
 					},
-					retries:   3,
-					srv_addr:  "example.com:53",
-					transport: "udp",
+					retries:        3,
+					srv_addr:       "example.com:53",
+					cnameConflicts: "warn",
+					transport:      "udp",
 				},
 			},
 		},
@@ -251,22 +278,24 @@ func TestDnsProviderConfigure(t *testing.T) {
 			},
 			request: provider.ConfigureRequest{
 				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
+					"cname_conflicts": types.StringNull(),
 					"update": types.ListValueMust(
 						providerUpdateModel{}.objectType(),
 						[]attr.Value{
 							types.ObjectValueMust(
 								providerUpdateModel{}.objectAttributeTypes(),
 								map[string]attr.Value{
-									"gssapi":        types.ListNull(providerGssapiModel{}.objectType()),
-									"key_name":      types.StringNull(),
-									"key_algorithm": types.StringNull(),
-									"key_secret":    types.StringNull(),
-									"port":          types.Int64Null(),
-									"server":        types.StringValue("example.com"),
-									"retries":       types.Int64Null(),
-									"timeout":       types.StringNull(),
-									"transport":     types.StringNull(),
-									"recursive":     types.BoolNull(),
+									"gssapi":          types.ListNull(providerGssapiModel{}.objectType()),
+									"key_name":        types.StringNull(),
+									"key_algorithm":   types.StringNull(),
+									"key_secret":      types.StringNull(),
+									"key_secret_file": types.StringNull(),
+									"port":            types.Int64Null(),
+									"server":          types.StringValue("example.com"),
+									"retries":         types.Int64Null(),
+									"timeout":         types.StringNull(),
+									"transport":       types.StringNull(),
+									"recursive":       types.BoolNull(),
 								},
 							),
 						},
@@ -278,9 +307,10 @@ func TestDnsProviderConfigure(t *testing.T) {
 					c: &dns.Client{
 						Net: "udp",
 					},
-					retries:   3,
-					srv_addr:  "example.com:53",
-					transport: "udp",
+					retries:        3,
+					srv_addr:       "example.com:53",
+					cnameConflicts: "warn",
+					transport:      "udp",
 				},
 			},
 		},
@@ -290,7 +320,8 @@ func TestDnsProviderConfigure(t *testing.T) {
 			},
 			request: provider.ConfigureRequest{
 				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
-					"update": types.ListNull(providerUpdateModel{}.objectType()),
+					"cname_conflicts": types.StringNull(),
+					"update":          types.ListNull(providerUpdateModel{}.objectType()),
 				}),
 			},
 			expected: &provider.ConfigureResponse{
@@ -298,31 +329,34 @@ func TestDnsProviderConfigure(t *testing.T) {
 					c: &dns.Client{
 						Net: "udp",
 					},
-					retries:   3,
-					srv_addr:  "example.com:53",
-					transport: "udp",
+					retries:        3,
+					srv_addr:       "example.com:53",
+					cnameConflicts: "warn",
+					transport:      "udp",
 				},
 			},
 		},
 		"update-timeout-config-duration": {
 			request: provider.ConfigureRequest{
 				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
+					"cname_conflicts": types.StringNull(),
 					"update": types.ListValueMust(
 						providerUpdateModel{}.objectType(),
 						[]attr.Value{
 							types.ObjectValueMust(
 								providerUpdateModel{}.objectAttributeTypes(),
 								map[string]attr.Value{
-									"gssapi":        types.ListNull(providerGssapiModel{}.objectType()),
-									"key_name":      types.StringNull(),
-									"key_algorithm": types.StringNull(),
-									"key_secret":    types.StringNull(),
-									"port":          types.Int64Null(),
-									"server":        types.StringNull(),
-									"retries":       types.Int64Null(),
-									"timeout":       types.StringValue("5s"),
-									"transport":     types.StringNull(),
-									"recursive":     types.BoolNull(),
+									"gssapi":          types.ListNull(providerGssapiModel{}.objectType()),
+									"key_name":        types.StringNull(),
+									"key_algorithm":   types.StringNull(),
+									"key_secret":      types.StringNull(),
+									"key_secret_file": types.StringNull(),
+									"port":            types.Int64Null(),
+									"server":          types.StringNull(),
+									"retries":         types.Int64Null(),
+									"timeout":         types.StringValue("5s"),
+									"transport":       types.StringNull(),
+									"recursive":       types.BoolNull(),
 								},
 							),
 						},
@@ -335,31 +369,34 @@ func TestDnsProviderConfigure(t *testing.T) {
 						Net:     "udp",
 						Timeout: 5 * time.Second,
 					},
-					retries:   3,
-					srv_addr:  ":53",
-					transport: "udp",
+					retries:        3,
+					srv_addr:       ":53",
+					cnameConflicts: "warn",
+					transport:      "udp",
 				},
 			},
 		},
 		"update-timeout-config-number": {
 			request: provider.ConfigureRequest{
 				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
+					"cname_conflicts": types.StringNull(),
 					"update": types.ListValueMust(
 						providerUpdateModel{}.objectType(),
 						[]attr.Value{
 							types.ObjectValueMust(
 								providerUpdateModel{}.objectAttributeTypes(),
 								map[string]attr.Value{
-									"gssapi":        types.ListNull(providerGssapiModel{}.objectType()),
-									"key_name":      types.StringNull(),
-									"key_algorithm": types.StringNull(),
-									"key_secret":    types.StringNull(),
-									"port":          types.Int64Null(),
-									"server":        types.StringNull(),
-									"retries":       types.Int64Null(),
-									"timeout":       types.StringValue("5"),
-									"transport":     types.StringNull(),
-									"recursive":     types.BoolNull(),
+									"gssapi":          types.ListNull(providerGssapiModel{}.objectType()),
+									"key_name":        types.StringNull(),
+									"key_algorithm":   types.StringNull(),
+									"key_secret":      types.StringNull(),
+									"key_secret_file": types.StringNull(),
+									"port":            types.Int64Null(),
+									"server":          types.StringNull(),
+									"retries":         types.Int64Null(),
+									"timeout":         types.StringValue("5"),
+									"transport":       types.StringNull(),
+									"recursive":       types.BoolNull(),
 								},
 							),
 						},
@@ -372,9 +409,10 @@ func TestDnsProviderConfigure(t *testing.T) {
 						Net:     "udp",
 						Timeout: 5 * time.Second,
 					},
-					retries:   3,
-					srv_addr:  ":53",
-					transport: "udp",
+					retries:        3,
+					srv_addr:       ":53",
+					cnameConflicts: "warn",
+					transport:      "udp",
 				},
 			},
 		},
@@ -384,22 +422,24 @@ func TestDnsProviderConfigure(t *testing.T) {
 			},
 			request: provider.ConfigureRequest{
 				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
+					"cname_conflicts": types.StringNull(),
 					"update": types.ListValueMust(
 						providerUpdateModel{}.objectType(),
 						[]attr.Value{
 							types.ObjectValueMust(
 								providerUpdateModel{}.objectAttributeTypes(),
 								map[string]attr.Value{
-									"gssapi":        types.ListNull(providerGssapiModel{}.objectType()),
-									"key_name":      types.StringNull(),
-									"key_algorithm": types.StringNull(),
-									"key_secret":    types.StringNull(),
-									"port":          types.Int64Null(),
-									"server":        types.StringNull(),
-									"retries":       types.Int64Null(),
-									"timeout":       types.StringValue("5"),
-									"transport":     types.StringNull(),
-									"recursive":     types.BoolNull(),
+									"gssapi":          types.ListNull(providerGssapiModel{}.objectType()),
+									"key_name":        types.StringNull(),
+									"key_algorithm":   types.StringNull(),
+									"key_secret":      types.StringNull(),
+									"key_secret_file": types.StringNull(),
+									"port":            types.Int64Null(),
+									"server":          types.StringNull(),
+									"retries":         types.Int64Null(),
+									"timeout":         types.StringValue("5"),
+									"transport":       types.StringNull(),
+									"recursive":       types.BoolNull(),
 								},
 							),
 						},
@@ -412,9 +452,10 @@ func TestDnsProviderConfigure(t *testing.T) {
 						Net:     "udp",
 						Timeout: 5 * time.Second,
 					},
-					retries:   3,
-					srv_addr:  ":53",
-					transport: "udp",
+					retries:        3,
+					srv_addr:       ":53",
+					cnameConflicts: "warn",
+					transport:      "udp",
 				},
 			},
 		},
@@ -424,7 +465,8 @@ func TestDnsProviderConfigure(t *testing.T) {
 			},
 			request: provider.ConfigureRequest{
 				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
-					"update": types.ListNull(providerUpdateModel{}.objectType()),
+					"cname_conflicts": types.StringNull(),
+					"update":          types.ListNull(providerUpdateModel{}.objectType()),
 				}),
 			},
 			expected: &provider.ConfigureResponse{
@@ -433,9 +475,10 @@ func TestDnsProviderConfigure(t *testing.T) {
 						Net:     "udp",
 						Timeout: 5 * time.Second,
 					},
-					retries:   3,
-					srv_addr:  ":53",
-					transport: "udp",
+					retries:        3,
+					srv_addr:       ":53",
+					cnameConflicts: "warn",
+					transport:      "udp",
 				},
 			},
 		},
@@ -445,7 +488,8 @@ func TestDnsProviderConfigure(t *testing.T) {
 			},
 			request: provider.ConfigureRequest{
 				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
-					"update": types.ListNull(providerUpdateModel{}.objectType()),
+					"cname_conflicts": types.StringNull(),
+					"update":          types.ListNull(providerUpdateModel{}.objectType()),
 				}),
 			},
 			expected: &provider.ConfigureResponse{
@@ -454,9 +498,10 @@ func TestDnsProviderConfigure(t *testing.T) {
 						Net:     "udp",
 						Timeout: 5 * time.Second,
 					},
-					retries:   3,
-					srv_addr:  ":53",
-					transport: "udp",
+					retries:        3,
+					srv_addr:       ":53",
+					cnameConflicts: "warn",
+					transport:      "udp",
 				},
 			},
 		},
@@ -466,7 +511,8 @@ func TestDnsProviderConfigure(t *testing.T) {
 			},
 			request: provider.ConfigureRequest{
 				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
-					"update": types.ListNull(providerUpdateModel{}.objectType()),
+					"cname_conflicts": types.StringNull(),
+					"update":          types.ListNull(providerUpdateModel{}.objectType()),
 				}),
 			},
 			expected: &provider.ConfigureResponse{
@@ -482,22 +528,24 @@ func TestDnsProviderConfigure(t *testing.T) {
 		"update-transport-config": {
 			request: provider.ConfigureRequest{
 				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
+					"cname_conflicts": types.StringNull(),
 					"update": types.ListValueMust(
 						providerUpdateModel{}.objectType(),
 						[]attr.Value{
 							types.ObjectValueMust(
 								providerUpdateModel{}.objectAttributeTypes(),
 								map[string]attr.Value{
-									"gssapi":        types.ListNull(providerGssapiModel{}.objectType()),
-									"key_name":      types.StringNull(),
-									"key_algorithm": types.StringNull(),
-									"key_secret":    types.StringNull(),
-									"port":          types.Int64Null(),
-									"server":        types.StringNull(),
-									"retries":       types.Int64Null(),
-									"timeout":       types.StringNull(),
-									"transport":     types.StringValue("tcp"),
-									"recursive":     types.BoolNull(),
+									"gssapi":          types.ListNull(providerGssapiModel{}.objectType()),
+									"key_name":        types.StringNull(),
+									"key_algorithm":   types.StringNull(),
+									"key_secret":      types.StringNull(),
+									"key_secret_file": types.StringNull(),
+									"port":            types.Int64Null(),
+									"server":          types.StringNull(),
+									"retries":         types.Int64Null(),
+									"timeout":         types.StringNull(),
+									"transport":       types.StringValue("tcp"),
+									"recursive":       types.BoolNull(),
 								},
 							),
 						},
@@ -509,9 +557,10 @@ func TestDnsProviderConfigure(t *testing.T) {
 					c: &dns.Client{
 						Net: "tcp",
 					},
-					retries:   3,
-					srv_addr:  ":53",
-					transport: "tcp",
+					retries:        3,
+					srv_addr:       ":53",
+					cnameConflicts: "warn",
+					transport:      "tcp",
 				},
 			},
 		},
@@ -521,22 +570,24 @@ func TestDnsProviderConfigure(t *testing.T) {
 			},
 			request: provider.ConfigureRequest{
 				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
+					"cname_conflicts": types.StringNull(),
 					"update": types.ListValueMust(
 						providerUpdateModel{}.objectType(),
 						[]attr.Value{
 							types.ObjectValueMust(
 								providerUpdateModel{}.objectAttributeTypes(),
 								map[string]attr.Value{
-									"gssapi":        types.ListNull(providerGssapiModel{}.objectType()),
-									"key_name":      types.StringNull(),
-									"key_algorithm": types.StringNull(),
-									"key_secret":    types.StringNull(),
-									"port":          types.Int64Null(),
-									"server":        types.StringNull(),
-									"retries":       types.Int64Null(),
-									"timeout":       types.StringNull(),
-									"transport":     types.StringValue("tcp"),
-									"recursive":     types.BoolNull(),
+									"gssapi":          types.ListNull(providerGssapiModel{}.objectType()),
+									"key_name":        types.StringNull(),
+									"key_algorithm":   types.StringNull(),
+									"key_secret":      types.StringNull(),
+									"key_secret_file": types.StringNull(),
+									"port":            types.Int64Null(),
+									"server":          types.StringNull(),
+									"retries":         types.Int64Null(),
+									"timeout":         types.StringNull(),
+									"transport":       types.StringValue("tcp"),
+									"recursive":       types.BoolNull(),
 								},
 							),
 						},
@@ -548,9 +599,10 @@ func TestDnsProviderConfigure(t *testing.T) {
 					c: &dns.Client{
 						Net: "tcp",
 					},
-					retries:   3,
-					srv_addr:  ":53",
-					transport: "tcp",
+					retries:        3,
+					srv_addr:       ":53",
+					cnameConflicts: "warn",
+					transport:      "tcp",
 				},
 			},
 		},
@@ -560,7 +612,8 @@ func TestDnsProviderConfigure(t *testing.T) {
 			},
 			request: provider.ConfigureRequest{
 				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
-					"update": types.ListNull(providerUpdateModel{}.objectType()),
+					"cname_conflicts": types.StringNull(),
+					"update":          types.ListNull(providerUpdateModel{}.objectType()),
 				}),
 			},
 			expected: &provider.ConfigureResponse{
@@ -568,31 +621,34 @@ func TestDnsProviderConfigure(t *testing.T) {
 					c: &dns.Client{
 						Net: "tcp",
 					},
-					retries:   3,
-					srv_addr:  ":53",
-					transport: "tcp",
+					retries:        3,
+					srv_addr:       ":53",
+					cnameConflicts: "warn",
+					transport:      "tcp",
 				},
 			},
 		},
 		"update-recursive-config": {
 			request: provider.ConfigureRequest{
 				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
+					"cname_conflicts": types.StringNull(),
 					"update": types.ListValueMust(
 						providerUpdateModel{}.objectType(),
 						[]attr.Value{
 							types.ObjectValueMust(
 								providerUpdateModel{}.objectAttributeTypes(),
 								map[string]attr.Value{
-									"gssapi":        types.ListNull(providerGssapiModel{}.objectType()),
-									"key_name":      types.StringNull(),
-									"key_algorithm": types.StringNull(),
-									"key_secret":    types.StringNull(),
-									"port":          types.Int64Null(),
-									"server":        types.StringNull(),
-									"retries":       types.Int64Null(),
-									"timeout":       types.StringNull(),
-									"transport":     types.StringNull(),
-									"recursive":     types.BoolValue(true),
+									"gssapi":          types.ListNull(providerGssapiModel{}.objectType()),
+									"key_name":        types.StringNull(),
+									"key_algorithm":   types.StringNull(),
+									"key_secret":      types.StringNull(),
+									"key_secret_file": types.StringNull(),
+									"port":            types.Int64Null(),
+									"server":          types.StringNull(),
+									"retries":         types.Int64Null(),
+									"timeout":         types.StringNull(),
+									"transport":       types.StringNull(),
+									"recursive":       types.BoolValue(true),
 								},
 							),
 						},
@@ -604,10 +660,11 @@ func TestDnsProviderConfigure(t *testing.T) {
 					c: &dns.Client{
 						Net: "udp",
 					},
-					retries:   3,
-					srv_addr:  ":53",
-					transport: "udp",
-					recursive: true,
+					retries:        3,
+					srv_addr:       ":53",
+					cnameConflicts: "warn",
+					transport:      "udp",
+					recursive:      true,
 				},
 			},
 		},
@@ -617,22 +674,24 @@ func TestDnsProviderConfigure(t *testing.T) {
 			},
 			request: provider.ConfigureRequest{
 				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
+					"cname_conflicts": types.StringNull(),
 					"update": types.ListValueMust(
 						providerUpdateModel{}.objectType(),
 						[]attr.Value{
 							types.ObjectValueMust(
 								providerUpdateModel{}.objectAttributeTypes(),
 								map[string]attr.Value{
-									"gssapi":        types.ListNull(providerGssapiModel{}.objectType()),
-									"key_name":      types.StringNull(),
-									"key_algorithm": types.StringNull(),
-									"key_secret":    types.StringNull(),
-									"port":          types.Int64Null(),
-									"server":        types.StringNull(),
-									"retries":       types.Int64Null(),
-									"timeout":       types.StringNull(),
-									"transport":     types.StringNull(),
-									"recursive":     types.BoolValue(false),
+									"gssapi":          types.ListNull(providerGssapiModel{}.objectType()),
+									"key_name":        types.StringNull(),
+									"key_algorithm":   types.StringNull(),
+									"key_secret":      types.StringNull(),
+									"key_secret_file": types.StringNull(),
+									"port":            types.Int64Null(),
+									"server":          types.StringNull(),
+									"retries":         types.Int64Null(),
+									"timeout":         types.StringNull(),
+									"transport":       types.StringNull(),
+									"recursive":       types.BoolValue(false),
 								},
 							),
 						},
@@ -644,10 +703,11 @@ func TestDnsProviderConfigure(t *testing.T) {
 					c: &dns.Client{
 						Net: "udp",
 					},
-					retries:   3,
-					srv_addr:  ":53",
-					transport: "udp",
-					recursive: false,
+					retries:        3,
+					srv_addr:       ":53",
+					cnameConflicts: "warn",
+					transport:      "udp",
+					recursive:      false,
 				},
 			},
 		},
@@ -657,7 +717,175 @@ func TestDnsProviderConfigure(t *testing.T) {
 			},
 			request: provider.ConfigureRequest{
 				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
-					"update": types.ListNull(providerUpdateModel{}.objectType()),
+					"cname_conflicts": types.StringNull(),
+					"update":          types.ListNull(providerUpdateModel{}.objectType()),
+				}),
+			},
+			expected: &provider.ConfigureResponse{
+				ResourceData: &DNSClient{
+					c: &dns.Client{
+						Net: "udp",
+					},
+					retries:        3,
+					srv_addr:       ":53",
+					cnameConflicts: "warn",
+					transport:      "udp",
+					recursive:      true,
+				},
+			},
+		},
+		"update-key-secret-file-config": {
+			request: provider.ConfigureRequest{
+				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
+					"cname_conflicts": types.StringNull(),
+					"update": types.ListValueMust(
+						providerUpdateModel{}.objectType(),
+						[]attr.Value{
+							types.ObjectValueMust(
+								providerUpdateModel{}.objectAttributeTypes(),
+								map[string]attr.Value{
+									"gssapi":          types.ListNull(providerGssapiModel{}.objectType()),
+									"key_name":        types.StringValue("tsig-key."),
+									"key_algorithm":   types.StringValue("hmac-sha256"),
+									"key_secret":      types.StringNull(),
+									"key_secret_file": types.StringValue(keySecretFile),
+									"port":            types.Int64Null(),
+									"server":          types.StringNull(),
+									"retries":         types.Int64Null(),
+									"timeout":         types.StringNull(),
+									"transport":       types.StringNull(),
+									"recursive":       types.BoolNull(),
+								},
+							),
+						},
+					),
+				}),
+			},
+			expected: &provider.ConfigureResponse{
+				ResourceData: &DNSClient{
+					c: &dns.Client{
+						Net:          "udp",
+						TsigProvider: tsig.HMAC{"tsig-key.": "c2VjcmV0"},
+					},
+					retries:        3,
+					srv_addr:       ":53",
+					keyname:        "tsig-key.",
+					keysecret:      "c2VjcmV0",
+					keyalgo:        dns.HmacSHA256,
+					cnameConflicts: "warn",
+					transport:      "udp",
+				},
+			},
+		},
+		"update-key-secret-file-env": {
+			env: map[string]string{
+				"DNS_UPDATE_KEYSECRET_FILE": keySecretFile,
+			},
+			request: provider.ConfigureRequest{
+				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
+					"cname_conflicts": types.StringNull(),
+					"update": types.ListValueMust(
+						providerUpdateModel{}.objectType(),
+						[]attr.Value{
+							types.ObjectValueMust(
+								providerUpdateModel{}.objectAttributeTypes(),
+								map[string]attr.Value{
+									"gssapi":          types.ListNull(providerGssapiModel{}.objectType()),
+									"key_name":        types.StringValue("tsig-key."),
+									"key_algorithm":   types.StringValue("hmac-sha256"),
+									"key_secret":      types.StringNull(),
+									"key_secret_file": types.StringNull(),
+									"port":            types.Int64Null(),
+									"server":          types.StringNull(),
+									"retries":         types.Int64Null(),
+									"timeout":         types.StringNull(),
+									"transport":       types.StringNull(),
+									"recursive":       types.BoolNull(),
+								},
+							),
+						},
+					),
+				}),
+			},
+			expected: &provider.ConfigureResponse{
+				ResourceData: &DNSClient{
+					c: &dns.Client{
+						Net:          "udp",
+						TsigProvider: tsig.HMAC{"tsig-key.": "c2VjcmV0"},
+					},
+					retries:        3,
+					srv_addr:       ":53",
+					keyname:        "tsig-key.",
+					keysecret:      "c2VjcmV0",
+					keyalgo:        dns.HmacSHA256,
+					cnameConflicts: "warn",
+					transport:      "udp",
+				},
+			},
+		},
+		"update-key-secret-file-missing": {
+			request: provider.ConfigureRequest{
+				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
+					"cname_conflicts": types.StringNull(),
+					"update": types.ListValueMust(
+						providerUpdateModel{}.objectType(),
+						[]attr.Value{
+							types.ObjectValueMust(
+								providerUpdateModel{}.objectAttributeTypes(),
+								map[string]attr.Value{
+									"gssapi":          types.ListNull(providerGssapiModel{}.objectType()),
+									"key_name":        types.StringValue("tsig-key."),
+									"key_algorithm":   types.StringValue("hmac-sha256"),
+									"key_secret":      types.StringNull(),
+									"key_secret_file": types.StringValue(missingFile),
+									"port":            types.Int64Null(),
+									"server":          types.StringNull(),
+									"retries":         types.Int64Null(),
+									"timeout":         types.StringNull(),
+									"transport":       types.StringNull(),
+									"recursive":       types.BoolNull(),
+								},
+							),
+						},
+					),
+				}),
+			},
+			expected: &provider.ConfigureResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Error reading TSIG key secret file:",
+						"open "+missingFile+": no such file or directory",
+					),
+				},
+			},
+		},
+		"cname-conflicts-config": {
+			request: provider.ConfigureRequest{
+				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
+					"cname_conflicts": types.StringValue("error"),
+					"update":          types.ListNull(providerUpdateModel{}.objectType()),
+				}),
+			},
+			expected: &provider.ConfigureResponse{
+				ResourceData: &DNSClient{
+					c: &dns.Client{
+						Net: "udp",
+					},
+					retries:        3,
+					srv_addr:       ":53",
+					cnameConflicts: "error",
+					transport:      "udp",
+				},
+			},
+		},
+		"cname-conflicts-config-and-env": {
+			env: map[string]string{
+				"DNS_CNAME_CONFLICTS": "ignore",
+			},
+			request: provider.ConfigureRequest{
+				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
+					"cname_conflicts": types.StringValue("error"),
+					"update":          types.ListNull(providerUpdateModel{}.objectType()),
 				}),
 			},
 			expected: &provider.ConfigureResponse{
@@ -665,10 +893,51 @@ func TestDnsProviderConfigure(t *testing.T) {
 					c: &dns.Client{
 						Net: "udp",
 					},
-					retries:   3,
-					srv_addr:  ":53",
-					transport: "udp",
-					recursive: true,
+					retries:        3,
+					srv_addr:       ":53",
+					cnameConflicts: "error",
+					transport:      "udp",
+				},
+			},
+		},
+		"cname-conflicts-env": {
+			env: map[string]string{
+				"DNS_CNAME_CONFLICTS": "ignore",
+			},
+			request: provider.ConfigureRequest{
+				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
+					"cname_conflicts": types.StringNull(),
+					"update":          types.ListNull(providerUpdateModel{}.objectType()),
+				}),
+			},
+			expected: &provider.ConfigureResponse{
+				ResourceData: &DNSClient{
+					c: &dns.Client{
+						Net: "udp",
+					},
+					retries:        3,
+					srv_addr:       ":53",
+					cnameConflicts: "ignore",
+					transport:      "udp",
+				},
+			},
+		},
+		"cname-conflicts-env-invalid": {
+			env: map[string]string{
+				"DNS_CNAME_CONFLICTS": "fail",
+			},
+			request: provider.ConfigureRequest{
+				Config: testProviderSchemaConfig(t, ctx, schema, map[string]attr.Value{
+					"cname_conflicts": types.StringNull(),
+					"update":          types.ListNull(providerUpdateModel{}.objectType()),
+				}),
+			},
+			expected: &provider.ConfigureResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid DNS_CNAME_CONFLICTS environment variable:",
+						`expected one of "warn", "error" or "ignore", got "fail"`,
+					),
 				},
 			},
 		},
@@ -686,9 +955,83 @@ func TestDnsProviderConfigure(t *testing.T) {
 
 			testProvider.Configure(ctx, testCase.request, got)
 
-			if diff := cmp.Diff(got, testCase.expected, cmp.AllowUnexported(DNSClient{}), cmpopts.IgnoreUnexported(dns.Client{})); diff != "" {
+			// Data sources and list resources are configured with the same client
+			if testCase.expected.ResourceData != nil {
+				testCase.expected.DataSourceData = testCase.expected.ResourceData
+				testCase.expected.ListResourceData = testCase.expected.ResourceData
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, cmp.AllowUnexported(DNSClient{}), cmpopts.IgnoreUnexported(dns.Client{}), cmpopts.IgnoreFields(DNSClient{}, "zones")); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
 			}
 		})
 	}
 }
+
+func TestResourceDnsIdentityConfig(t *testing.T) {
+	ctx := context.Background()
+	identitySchema := recordIdentitySchema()
+
+	identity := func(zone, name, rrType tftypes.Value) *tfsdk.ResourceIdentity {
+		return &tfsdk.ResourceIdentity{
+			Schema: identitySchema,
+			Raw: tftypes.NewValue(identitySchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"zone": zone,
+				"name": name,
+				"type": rrType,
+			}),
+		}
+	}
+
+	testCases := map[string]struct {
+		identity *tfsdk.ResourceIdentity
+		expected dnsConfig
+		err      bool
+	}{
+		"identity": {
+			identity: identity(tftypes.NewValue(tftypes.String, "example.com."), tftypes.NewValue(tftypes.String, "foo"), tftypes.NewValue(tftypes.String, "a")),
+			expected: dnsConfig{Name: "foo", Zone: "example.com."},
+		},
+		"identity-apex": {
+			identity: identity(tftypes.NewValue(tftypes.String, "example.com."), tftypes.NewValue(tftypes.String, nil), tftypes.NewValue(tftypes.String, nil)),
+			expected: dnsConfig{Zone: "example.com."},
+		},
+		// The name is not moved to a zone delegated from the zone
+		"identity-multiple-labels": {
+			identity: identity(tftypes.NewValue(tftypes.String, "example.com."), tftypes.NewValue(tftypes.String, "foo.sub"), tftypes.NewValue(tftypes.String, nil)),
+			expected: dnsConfig{Name: "foo.sub", Zone: "example.com."},
+		},
+		"identity-relative-zone": {
+			identity: identity(tftypes.NewValue(tftypes.String, "example.com"), tftypes.NewValue(tftypes.String, "foo"), tftypes.NewValue(tftypes.String, nil)),
+			err:      true,
+		},
+		"identity-wrong-type": {
+			identity: identity(tftypes.NewValue(tftypes.String, "example.com."), tftypes.NewValue(tftypes.String, "foo"), tftypes.NewValue(tftypes.String, "AAAA")),
+			err:      true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config, diags := resourceDnsIdentityConfig_framework(ctx, testCase.identity, dns.TypeA)
+			if diags.HasError() != testCase.err {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if config != testCase.expected {
+				t.Errorf("expected %+v, got %+v", testCase.expected, config)
+			}
+		})
+	}
+}
+
+func TestRecordIdentity(t *testing.T) {
+	identity := recordIdentity(dnsConfig{Zone: "example.com."}, dns.TypeA)
+	if !identity.Name.IsNull() {
+		t.Errorf("expected a null name for the apex, got %s", identity.Name)
+	}
+
+	identity = recordIdentity(dnsConfig{Name: "foo", Zone: "example.com."}, dns.TypeA)
+	if identity.Name.ValueString() != "foo" {
+		t.Errorf("expected name foo, got %s", identity.Name)
+	}
+}
@@ -14,22 +14,16 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
-	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
 )
 
 var dnsClient *DNSClient
 var testProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
-	"dns": func() (tfprotov5.ProviderServer, error) {
-		providers := []func() tfprotov5.ProviderServer{
-			providerserver.NewProtocol5(NewFrameworkProvider()),
-			New().GRPCProvider,
-		}
-		return tf5muxserver.NewMuxServer(context.Background(), providers...)
-	},
+	"dns": providerserver.NewProtocol5WithError(NewFrameworkProvider()),
 }
 
 func providerVersion324() map[string]resource.ExternalProvider {
@@ -51,16 +45,6 @@ func TestMain(m *testing.M) {
 	m.Run()
 }
 
-func TestProvider(t *testing.T) {
-	if err := New().InternalValidate(); err != nil {
-		t.Fatalf("err: %s", err)
-	}
-}
-
-func TestProvider_impl(t *testing.T) {
-	var _ *schema.Provider = New()
-}
-
 func testAccPreCheck(t *testing.T) {
 	v := os.Getenv("DNS_UPDATE_SERVER")
 	if v == "" {
@@ -486,15 +470,14 @@ func testRemoveRecord(t *testing.T, recordType string, recordName string) {
 
 	msg.SetUpdate(recordZone)
 
-	rrStr := fmt.Sprintf("%s.%s 0 %s", recordName, recordZone, recordType)
-
-	rr, err := dns.NewRR(rrStr)
+	rrStr := fmt.Sprintf("%s.%s %s", recordName, recordZone, recordType)
 
-	if err != nil {
-		t.Fatalf("Error generating DNS record (%s): %s", rrStr, err)
+	rrType, ok := dns.StringToType[recordType]
+	if !ok {
+		t.Fatalf("Error generating DNS record (%s): unknown type", rrStr)
 	}
 
-	msg.RemoveRRset([]dns.RR{rr})
+	msg.RemoveRRset([]dns.RR{rdata.NewEmpty(recordName+"."+recordZone, rrType)})
 
 	resp, err := exchange(msg, true, dnsClient)
 
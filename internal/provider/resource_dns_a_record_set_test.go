@@ -4,10 +4,15 @@
 package provider
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/miekg/dns"
 )
 
@@ -69,6 +74,179 @@ func TestAccDnsARecordSet_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDnsARecordSet_FQDN(t *testing.T) {
+
+	resourceName := "dns_a_record_set.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDnsARecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsARecordSet_fqdn,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "zone", "example.com."),
+					resource.TestCheckResourceAttr(resourceName, "name", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "fqdn", "foo.example.com."),
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "addresses.*", "192.168.0.1"),
+				),
+			},
+			{
+				Config: testAccDnsARecordSet_fqdnZone,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccDnsARecordSet_Upgrade(t *testing.T) {
+	resourceName := "dns_a_record_set.foo"
+	resourceRoot := "dns_a_record_set.root"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckDnsARecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion324(),
+				Config:            testAccDnsARecordSet_basic + testAccDnsARecordSet_root,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "2"),
+					resource.TestCheckResourceAttr(resourceRoot, "addresses.#", "1"),
+				),
+			},
+			{
+				ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+				Config:                   testAccDnsARecordSet_basic + testAccDnsARecordSet_root,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "foo.example.com."),
+					resource.TestCheckResourceAttr(resourceName, "fqdn", "foo.example.com."),
+					resource.TestCheckResourceAttr(resourceRoot, "id", "example.com."),
+					resource.TestCheckNoResourceAttr(resourceRoot, "name"),
+				),
+			},
+			{
+				ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+				Config:                   testAccDnsARecordSet_update + testAccDnsARecordSet_root,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDnsARecordSet_ManagePTR(t *testing.T) {
+	resourceName := "dns_a_record_set.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckDnsARecordSetDestroy,
+			testAccCheckDnsPTR("192.168.1.10", "", 0),
+			testAccCheckDnsPTR("192.168.1.11", "", 0),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsARecordSet_managePTR,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manage_ptr", "true"),
+					testAccCheckDnsPTR("192.168.1.10", "foo.example.com.", 1),
+				),
+			},
+			{
+				Config: testAccDnsARecordSet_managePTRUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDnsPTR("192.168.1.10", "", 0),
+					testAccCheckDnsPTR("192.168.1.11", "foo.example.com.", 1),
+				),
+			},
+			{
+				Config: testAccDnsARecordSet_managePTRDisabled,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manage_ptr", "false"),
+					testAccCheckDnsPTR("192.168.1.11", "", 0),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckDnsPTR checks that the reverse name of addr has expected PTR
+// records, all pointing to target.
+func testAccCheckDnsPTR(addr, target string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		name, err := dns.ReverseAddr(addr)
+		if err != nil {
+			return err
+		}
+
+		msg := new(dns.Msg)
+		msg.SetQuestion(name, dns.TypePTR)
+		r, err := exchange(msg, false, dnsClient)
+		if err != nil {
+			return fmt.Errorf("Error querying DNS record: %s", err)
+		}
+
+		count := 0
+		for _, record := range r.Answer {
+			if ptr, ok := record.(*dns.PTR); ok {
+				if ptr.Ptr != target {
+					return fmt.Errorf("expected PTR record of %s pointing to %s, got %s", addr, target, ptr.Ptr)
+				}
+				count++
+			}
+		}
+		if count != expected {
+			return fmt.Errorf("expected %d PTR records for %s, got %d", expected, addr, count)
+		}
+
+		return nil
+	}
+}
+
+func TestAccDnsARecordSet_Identity(t *testing.T) {
+	resourceName := "dns_a_record_set.foo"
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDnsARecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsARecordSet_basic,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						"zone": knownvalue.StringExact("example.com."),
+						"name": knownvalue.StringExact("foo"),
+						"type": knownvalue.StringExact("A"),
+					}),
+				},
+			},
+			{
+				ResourceName:    resourceName,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 func testAccCheckDnsARecordSetDestroy(s *terraform.State) error {
 	return testAccCheckDnsDestroy(s, "dns_a_record_set", dns.TypeA)
 }
@@ -95,3 +273,44 @@ var testAccDnsARecordSet_root = `
     addresses = ["192.168.0.1"]
     ttl = 300
   }`
+
+var testAccDnsARecordSet_fqdn = `
+  resource "dns_a_record_set" "foo" {
+    fqdn = "foo.example.com."
+    addresses = ["192.168.0.1"]
+    ttl = 300
+  }`
+
+var testAccDnsARecordSet_fqdnZone = `
+  resource "dns_a_record_set" "foo" {
+    zone = "example.com."
+    name = "foo"
+    addresses = ["192.168.0.1"]
+    ttl = 300
+  }`
+
+var testAccDnsARecordSet_managePTR = `
+  resource "dns_a_record_set" "foo" {
+    zone = "example.com."
+    name = "foo"
+    addresses = ["192.168.1.10"]
+    ttl = 300
+    manage_ptr = true
+  }`
+
+var testAccDnsARecordSet_managePTRUpdate = `
+  resource "dns_a_record_set" "foo" {
+    zone = "example.com."
+    name = "foo"
+    addresses = ["192.168.1.11"]
+    ttl = 300
+    manage_ptr = true
+  }`
+
+var testAccDnsARecordSet_managePTRDisabled = `
+  resource "dns_a_record_set" "foo" {
+    zone = "example.com."
+    name = "foo"
+    addresses = ["192.168.1.11"]
+    ttl = 300
+  }`
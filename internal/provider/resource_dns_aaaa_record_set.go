@@ -8,169 +8,514 @@ import (
 	"fmt"
 	"sort"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/dnstypes"
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
+	"github.com/hashicorp/terraform-provider-dns/internal/validators/dnsvalidator"
 )
 
-func resourceDnsAAAARecordSet() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceDnsAAAARecordSetCreate,
-		ReadContext:   resourceDnsAAAARecordSetRead,
-		UpdateContext: resourceDnsAAAARecordSetUpdate,
-		DeleteContext: resourceDnsAAAARecordSetDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: resourceDnsImport,
-		},
+var (
+	_ resource.Resource                 = (*dnsAAAARecordSetResource)(nil)
+	_ resource.ResourceWithImportState  = (*dnsAAAARecordSetResource)(nil)
+	_ resource.ResourceWithIdentity     = (*dnsAAAARecordSetResource)(nil)
+	_ resource.ResourceWithConfigure    = (*dnsAAAARecordSetResource)(nil)
+	_ resource.ResourceWithModifyPlan   = (*dnsAAAARecordSetResource)(nil)
+	_ resource.ResourceWithUpgradeState = (*dnsAAAARecordSetResource)(nil)
+)
+
+func NewDnsAAAARecordSetResource() resource.Resource {
+	return &dnsAAAARecordSetResource{}
+}
+
+type dnsAAAARecordSetResource struct {
+	client *DNSClient
+}
+
+func (d *dnsAAAARecordSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_aaaa_record_set"
+}
 
-		Schema: map[string]*schema.Schema{
-			"zone": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validateZone,
-				Description: "DNS zone the record set belongs to. It must be an FQDN, that is, include the trailing " +
-					"dot.",
+func (d *dnsAAAARecordSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     1,
+		Description: "Creates an AAAA type DNS record set.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					dnsvalidator.IsZoneNameValid(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("fqdn")),
+				},
+				Description: "DNS zone the record set belongs to. It must be an FQDN, that is, include the trailing dot. " +
+					"Exactly one of `zone` or `fqdn` must be set.",
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					dnsvalidator.IsRecordNameValid(),
+					stringvalidator.ConflictsWith(path.MatchRoot("fqdn")),
+				},
+				Description: "The name of the record set. The `zone` argument will be appended to this value to create " +
+					"the full record path.",
 			},
-			"name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ValidateFunc: validateName,
-				Description: "The name of the record set. The `zone` argument will be appended to this value to " +
-					"create the full record path.",
+			"fqdn": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					dnsvalidator.IsRecordFQDNValid(),
+				},
+				Description: "The fully qualified domain name of the record set, as an alternative to `zone` and `name`. " +
+					"The enclosing zone is discovered by looking up SOA records and `zone` and `name` are computed " +
+					"from it. It must include the trailing dot.",
 			},
-			"addresses": {
-				Type:        schema.TypeSet,
+			"addresses": schema.SetAttribute{
 				Required:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				Set:         hashIPString,
+				ElementType: dnstypes.IPAddressType{},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(dnsvalidator.IsIPv6AddressValid()),
+				},
 				Description: "The IPv6 addresses this record set will point to.",
 			},
-			"ttl": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				ForceNew:    true,
-				Default:     3600,
+			"ttl": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(3600),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 				Description: "The TTL of the record set. Defaults to `3600`.",
 			},
+			"manage_ptr": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "Whether to maintain a PTR record pointing the reverse name of each address to the " +
+					"record set, with the same TTL. The reverse zone of each address is discovered by looking up SOA " +
+					"records and must accept dynamic updates. Other PTR records of the reverse names are left in " +
+					"place. Defaults to `false`.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Always set to the fully qualified domain name of the record set.",
+			},
 		},
+	}
+}
 
-		Description: "Creates an AAAA type DNS record set.",
+func (d *dnsAAAARecordSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
 	}
+
+	client, ok := req.ProviderData.(*DNSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DNSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
 }
 
-func resourceDnsAAAARecordSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func (d *dnsAAAARecordSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resourceDnsModifyPlan_framework(ctx, d.client, dns.TypeAAAA, req, resp)
+}
+
+func (d *dnsAAAARecordSetResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := resourceDnsAddressRecordSetSchemaV0()
+
+	return map[int64]resource.StateUpgrader{
+		// State written by the SDKv2 implementation of the resource.
+		0: {
+			PriorSchema: &schemaV0,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior addressRecordSetResourceModelV0
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgraded, diags := prior.upgrade(ctx)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
 
-	d.SetId(resourceFQDN(d))
+				resp.Diagnostics.Append(resp.State.Set(ctx, aaaaRecordSetResourceModel{
+					ID:        upgraded.ID,
+					Zone:      upgraded.Zone,
+					Name:      upgraded.Name,
+					FQDN:      upgraded.FQDN,
+					Addresses: upgraded.Addresses,
+					TTL:       upgraded.TTL,
+					ManagePTR: types.BoolValue(false),
+				})...)
+			},
+		},
+	}
+}
 
-	return resourceDnsAAAARecordSetUpdate(ctx, d, meta)
+func (d *dnsAAAARecordSetResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = recordIdentitySchema()
 }
 
-func resourceDnsAAAARecordSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func (d *dnsAAAARecordSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan aaaaRecordSetResourceModel
 
-	answers, err := resourceDnsRead(d, meta, dns.TypeAAAA)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, configDiags := resourceDnsConfig_framework(plan.Zone, plan.Name, plan.FQDN, d.client)
+	resp.Diagnostics.Append(configDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeAAAA))...)
+
+	fqdn := resourceFQDN_framework(config)
+	plan.ID = types.StringValue(fqdn)
+	plan.Zone = types.StringValue(config.Zone)
+	plan.Name = config.nameValue()
+	plan.FQDN = types.StringValue(fqdn)
+
+	msg := new(dns.Msg)
+	msg.SetUpdate(config.Zone)
+
+	var planAddresses []dnstypes.IPAddress
+
+	resp.Diagnostics.Append(plan.Addresses.ElementsAs(ctx, &planAddresses, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Loop through all the new addresses and insert them
+	for _, addr := range planAddresses {
+		rr_insert, err := rdata.NewAAAA(fqdn, plan.TTL.ValueInt64(), addr.ValueIP().String())
+		if err != nil {
+			resp.Diagnostics.AddError("Error building DNS record:", err.Error())
+			return
+		}
+
+		msg.Insert([]dns.RR{rr_insert})
+	}
+
+	r, err := exchange(msg, true, d.client)
 	if err != nil {
-		return err
+		resp.Diagnostics.AddError("Error updating DNS record:", err.Error())
+		return
+	}
+	if r.Rcode != dns.RcodeSuccess {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error updating DNS record: %v", r.Rcode), dns.RcodeToString[r.Rcode])
+		return
+	}
+
+	// The record set is saved to the state even if its PTR records could not
+	// be created, so it is tainted rather than left behind
+	if plan.ManagePTR.ValueBool() {
+		add, _ := ptrChanges(planAddresses, nil, true, false)
+		resp.Diagnostics.Append(ptrUpdate(fqdn, plan.TTL.ValueInt64(), add, nil, d.client)...)
+	}
+
+	answers, diags := resourceDnsRead_framework(config, d.client, dns.TypeAAAA)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
 	}
 
 	if len(answers) > 0 {
+		var ttl sort.IntSlice
+		var addresses []dnstypes.IPAddress
+
+		for _, record := range answers {
+			addr, t, err := rdata.AAAA(record)
+			if err != nil {
+				resp.Diagnostics.AddError("Error querying DNS record:", err.Error())
+				return
+			}
+			addresses = append(addresses, dnstypes.NewIPAddressValue(addr))
+			ttl = append(ttl, t)
+		}
+		sort.Sort(ttl)
+
+		var convertDiags diag.Diagnostics
+		plan.Addresses, convertDiags = types.SetValueFrom(ctx, dnstypes.IPAddressType{}, addresses)
+		if convertDiags.HasError() {
+			resp.Diagnostics.Append(convertDiags...)
+			return
+		}
+
+		plan.TTL = types.Int64Value(int64(ttl[0]))
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	}
+}
 
+func (d *dnsAAAARecordSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state aaaaRecordSetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := dnsConfig{
+		Name: state.Name.ValueString(),
+		Zone: state.Zone.ValueString(),
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeAAAA))...)
+
+	state.FQDN = types.StringValue(resourceFQDN_framework(config))
+	if state.ManagePTR.IsNull() {
+		state.ManagePTR = types.BoolValue(false)
+	}
+
+	answers, diags := resourceDnsRead_framework(config, d.client, dns.TypeAAAA)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if len(answers) > 0 {
 		var ttl sort.IntSlice
+		var addresses []dnstypes.IPAddress
 
-		addresses := schema.NewSet(hashIPString, nil)
 		for _, record := range answers {
-			addr, t, err := getAAAAVal(record)
+			addr, t, err := rdata.AAAA(record)
 			if err != nil {
-				return diag.Errorf("Error querying DNS record: %s", err)
+				resp.Diagnostics.AddError("Error querying DNS record:", err.Error())
+				return
 			}
-			addresses.Add(addr)
+			addresses = append(addresses, dnstypes.NewIPAddressValue(addr))
 			ttl = append(ttl, t)
 		}
 		sort.Sort(ttl)
 
-		//nolint:errcheck
-		d.Set("addresses", addresses)
-		//nolint:errcheck
-		d.Set("ttl", ttl[0])
+		var convertDiags diag.Diagnostics
+		state.Addresses, convertDiags = types.SetValueFrom(ctx, dnstypes.IPAddressType{}, addresses)
+		if convertDiags.HasError() {
+			resp.Diagnostics.Append(convertDiags...)
+			return
+		}
+
+		state.TTL = types.Int64Value(int64(ttl[0]))
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	} else {
-		d.SetId("")
+		resp.State.RemoveResource(ctx)
 	}
-
-	return nil
 }
 
-func resourceDnsAAAARecordSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func (d *dnsAAAARecordSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state aaaaRecordSetResourceModel
 
-	if meta != nil {
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		//nolint:forcetypeassert
-		ttl := d.Get("ttl").(int)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		rec_fqdn := resourceFQDN(d)
+	config := dnsConfig{
+		Name: plan.Name.ValueString(),
+		Zone: plan.Zone.ValueString(),
+	}
 
-		msg := new(dns.Msg)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeAAAA))...)
 
-		//nolint:forcetypeassert
-		msg.SetUpdate(d.Get("zone").(string))
+	fqdn := resourceFQDN_framework(config)
+	state.FQDN = types.StringValue(fqdn)
 
-		if d.HasChange("addresses") {
-			o, n := d.GetChange("addresses")
-			//nolint:forcetypeassert
-			os := o.(*schema.Set)
-			//nolint:forcetypeassert
-			ns := n.(*schema.Set)
-			remove := os.Difference(ns).List()
-			add := ns.Difference(os).List()
+	var planAddresses, stateAddresses []dnstypes.IPAddress
 
-			// Loop through all the old addresses and remove them
-			for _, addr := range remove {
-				//nolint:forcetypeassert
-				rrStr := fmt.Sprintf("%s %d AAAA %s", rec_fqdn, ttl, stripLeadingZeros(addr.(string)))
+	resp.Diagnostics.Append(plan.Addresses.ElementsAs(ctx, &planAddresses, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-				rr_remove, err := dns.NewRR(rrStr)
-				if err != nil {
-					return diag.Errorf("error reading DNS record (%s): %s", rrStr, err)
-				}
+	resp.Diagnostics.Append(state.Addresses.ElementsAs(ctx, &stateAddresses, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-				msg.Remove([]dns.RR{rr_remove})
-			}
-			// Loop through all the new addresses and insert them
-			for _, addr := range add {
-				//nolint:forcetypeassert
-				rrStr := fmt.Sprintf("%s %d AAAA %s", rec_fqdn, ttl, stripLeadingZeros(addr.(string)))
-
-				rr_insert, err := dns.NewRR(rrStr)
-				if err != nil {
-					return diag.Errorf("error reading DNS record (%s): %s", rrStr, err)
-				}
+	// The PTR records are updated first, so that when they cannot be updated
+	// the record set and its state are left unchanged and the whole change is
+	// retried, adding and removing the PTR records of the other reverse zones
+	// again
+	addPTR, removePTR := ptrChanges(planAddresses, stateAddresses, plan.ManagePTR.ValueBool(), state.ManagePTR.ValueBool())
+	ptrDiags := ptrUpdate(fqdn, plan.TTL.ValueInt64(), addPTR, removePTR, d.client)
+	resp.Diagnostics.Append(ptrDiags...)
+	if ptrDiags.HasError() {
+		resp.State.Raw = req.State.Raw
+		return
+	}
+	state.ManagePTR = plan.ManagePTR
+
+	msg := new(dns.Msg)
+	msg.SetUpdate(plan.Zone.ValueString())
 
-				msg.Insert([]dns.RR{rr_insert})
+	if !plan.Addresses.Equal(state.Addresses) {
+		add, remove := addressDifference(planAddresses, stateAddresses)
+
+		// Loop through all the old addresses and remove them
+		for _, addr := range remove {
+			rr_remove, err := rdata.NewAAAA(fqdn, plan.TTL.ValueInt64(), addr)
+			if err != nil {
+				resp.Diagnostics.AddError("Error building DNS record:", err.Error())
+				return
 			}
 
-			dnsClient, ok := meta.(*DNSClient)
-			if !ok {
-				diag.Errorf("Error asserting meta to *DNSClient")
+			msg.Remove([]dns.RR{rr_remove})
+		}
+		// Loop through all the new addresses and insert them
+		for _, addr := range add {
+			rr_insert, err := rdata.NewAAAA(fqdn, plan.TTL.ValueInt64(), addr)
+			if err != nil {
+				resp.Diagnostics.AddError("Error building DNS record:", err.Error())
+				return
 			}
 
-			r, err := exchange(msg, true, dnsClient)
+			msg.Insert([]dns.RR{rr_insert})
+		}
+
+		if len(add) > 0 || len(remove) > 0 {
+			r, err := exchange(msg, true, d.client)
 			if err != nil {
-				d.SetId("")
-				return diag.Errorf("Error updating DNS record: %s", err)
+				resp.Diagnostics.AddError("Error updating DNS record:", err.Error())
+				return
 			}
 			if r.Rcode != dns.RcodeSuccess {
-				d.SetId("")
-				return diag.Errorf("Error updating DNS record: %v (%s)", r.Rcode, dns.RcodeToString[r.Rcode])
+				resp.Diagnostics.AddError(fmt.Sprintf("Error updating DNS record: %v", r.Rcode), dns.RcodeToString[r.Rcode])
+				return
 			}
 		}
+	}
 
-		return resourceDnsAAAARecordSetRead(ctx, d, meta)
-	} else {
-		return diag.Errorf("update server is not set")
+	answers, diags := resourceDnsRead_framework(config, d.client, dns.TypeAAAA)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
 	}
+
+	if len(answers) > 0 {
+		var ttl sort.IntSlice
+		var addresses []dnstypes.IPAddress
+
+		for _, record := range answers {
+			addr, t, err := rdata.AAAA(record)
+			if err != nil {
+				resp.Diagnostics.AddError("Error querying DNS record:", err.Error())
+				return
+			}
+			addresses = append(addresses, dnstypes.NewIPAddressValue(addr))
+			ttl = append(ttl, t)
+		}
+		sort.Sort(ttl)
+
+		var convertDiags diag.Diagnostics
+		state.Addresses, convertDiags = types.SetValueFrom(ctx, dnstypes.IPAddressType{}, addresses)
+		if convertDiags.HasError() {
+			resp.Diagnostics.Append(convertDiags...)
+			return
+		}
+
+		state.TTL = types.Int64Value(int64(ttl[0]))
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	}
+}
+
+func (d *dnsAAAARecordSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state aaaaRecordSetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := dnsConfig{
+		Name: state.Name.ValueString(),
+		Zone: state.Zone.ValueString(),
+	}
+
+	if state.ManagePTR.ValueBool() {
+		var stateAddresses []dnstypes.IPAddress
+
+		resp.Diagnostics.Append(state.Addresses.ElementsAs(ctx, &stateAddresses, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		_, remove := ptrChanges(nil, stateAddresses, false, true)
+		resp.Diagnostics.Append(ptrUpdate(resourceFQDN_framework(config), state.TTL.ValueInt64(), nil, remove, d.client)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resourceDnsDelete_framework(config, d.client, dns.TypeAAAA)...)
 }
 
-func resourceDnsAAAARecordSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func (d *dnsAAAARecordSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, config, diags := resourceDnsImport_framework(ctx, req, dns.TypeAAAA, d.client)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fqdn"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), config.Zone)...)
+	if config.Name != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), config.Name)...)
+	}
+}
 
-	return resourceDnsDelete(d, meta, dns.TypeAAAA)
+type aaaaRecordSetResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Zone      types.String `tfsdk:"zone"`
+	Name      types.String `tfsdk:"name"`
+	FQDN      types.String `tfsdk:"fqdn"`
+	Addresses types.Set    `tfsdk:"addresses"`
+	TTL       types.Int64  `tfsdk:"ttl"`
+	ManagePTR types.Bool   `tfsdk:"manage_ptr"`
 }
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/miekg/dns"
 )
@@ -111,6 +112,85 @@ func TestAccDnsAAAARecordSet_basic_root_expanded(t *testing.T) {
 	})
 }
 
+func TestAccDnsAAAARecordSet_Upgrade(t *testing.T) {
+	resourceName := "dns_aaaa_record_set.bar"
+	resourceRoot := "dns_aaaa_record_set.root"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckDnsAAAARecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion324(),
+				Config:            testAccDnsAAAARecordSet_basic + testAccDnsAAAARecordSet_root,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "2"),
+					resource.TestCheckResourceAttr(resourceRoot, "addresses.#", "1"),
+				),
+			},
+			{
+				ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+				Config:                   testAccDnsAAAARecordSet_basic + testAccDnsAAAARecordSet_root,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "bar.example.com."),
+					resource.TestCheckResourceAttr(resourceName, "fqdn", "bar.example.com."),
+					resource.TestCheckResourceAttr(resourceRoot, "id", "example.com."),
+					resource.TestCheckNoResourceAttr(resourceRoot, "name"),
+				),
+			},
+			{
+				ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+				Config:                   testAccDnsAAAARecordSet_update + testAccDnsAAAARecordSet_root,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDnsAAAARecordSet_ManagePTR(t *testing.T) {
+	resourceName := "dns_aaaa_record_set.bar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckDnsAAAARecordSetDestroy,
+			testAccCheckDnsPTR("2001:db8:1::10", "", 0),
+			testAccCheckDnsPTR("2001:db8:1::11", "", 0),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsAAAARecordSet_managePTR,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manage_ptr", "true"),
+					testAccCheckDnsPTR("2001:db8:1::10", "bar.example.com.", 1),
+				),
+			},
+			{
+				Config: testAccDnsAAAARecordSet_managePTRUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDnsPTR("2001:db8:1::10", "", 0),
+					testAccCheckDnsPTR("2001:db8:1::11", "bar.example.com.", 1),
+				),
+			},
+			{
+				Config: testAccDnsAAAARecordSet_managePTRDisabled,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manage_ptr", "false"),
+					testAccCheckDnsPTR("2001:db8:1::11", "", 0),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDnsAAAARecordSetDestroy(s *terraform.State) error {
 	return testAccCheckDnsDestroy(s, "dns_aaaa_record_set", dns.TypeAAAA)
 }
@@ -152,3 +232,29 @@ var testAccDnsAAAARecordSet_root_expanded = `
     addresses = ["fdd5:e282:0000:0000:5678:1234:9012:cafe"]
     ttl = 300
   }`
+
+var testAccDnsAAAARecordSet_managePTR = `
+  resource "dns_aaaa_record_set" "bar" {
+    zone = "example.com."
+    name = "bar"
+    addresses = ["2001:db8:1::10"]
+    ttl = 300
+    manage_ptr = true
+  }`
+
+var testAccDnsAAAARecordSet_managePTRUpdate = `
+  resource "dns_aaaa_record_set" "bar" {
+    zone = "example.com."
+    name = "bar"
+    addresses = ["2001:0db8:0001:0000:0000:0000:0000:0011"]
+    ttl = 300
+    manage_ptr = true
+  }`
+
+var testAccDnsAAAARecordSet_managePTRDisabled = `
+  resource "dns_aaaa_record_set" "bar" {
+    zone = "example.com."
+    name = "bar"
+    addresses = ["2001:db8:1::11"]
+    ttl = 300
+  }`
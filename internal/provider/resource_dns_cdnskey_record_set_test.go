@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/miekg/dns"
+)
+
+func TestAccDnsCDNSKEYRecordSet_Delete(t *testing.T) {
+	resourceName := "dns_cdnskey_record_set.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDnsCDNSKEYRecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsCDNSKEYRecordSet_delete,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cdnskey.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "cdnskey.*", map[string]string{
+						"flags":      "0",
+						"algorithm":  "0",
+						"public_key": "AA==",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDnsCDNSKEYRecordSetDestroy(s *terraform.State) error {
+	return testAccCheckDnsDestroy(s, "dns_cdnskey_record_set", dns.TypeCDNSKEY)
+}
+
+var testAccDnsCDNSKEYRecordSet_delete = `
+  resource "dns_cdnskey_record_set" "foo" {
+    zone = "example.com."
+    cdnskey {
+      flags = 0
+      algorithm = 0
+      public_key = "AA=="
+    }
+    ttl = 300
+  }`
@@ -0,0 +1,346 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
+	"github.com/hashicorp/terraform-provider-dns/internal/validators/dnsvalidator"
+)
+
+var (
+	_ resource.Resource                = (*dnsCDSRecordSetResource)(nil)
+	_ resource.ResourceWithImportState = (*dnsCDSRecordSetResource)(nil)
+	_ resource.ResourceWithIdentity    = (*dnsCDSRecordSetResource)(nil)
+	_ resource.ResourceWithConfigure   = (*dnsCDSRecordSetResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*dnsCDSRecordSetResource)(nil)
+)
+
+func NewDnsCDSRecordSetResource() resource.Resource {
+	return &dnsCDSRecordSetResource{}
+}
+
+type dnsCDSRecordSetResource struct {
+	client *DNSClient
+}
+
+func (d *dnsCDSRecordSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cds_record_set"
+}
+
+func (d *dnsCDSRecordSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a CDS type DNS record set, which a child zone publishes to request changes to its " +
+			"DS records in the parent zone as described in RFC 7344. A single record with key tag, algorithm and " +
+			"digest type `0` and digest `00` requests the removal of the DS records as described in RFC 8078.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					dnsvalidator.IsZoneNameValid(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("fqdn")),
+				},
+				Description: "DNS zone the record set belongs to. It must be an FQDN, that is, include the trailing dot. " +
+					"Exactly one of `zone` or `fqdn` must be set.",
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					dnsvalidator.IsRecordNameValid(),
+					stringvalidator.ConflictsWith(path.MatchRoot("fqdn")),
+				},
+				Description: "The name of the record set. The `zone` argument will be appended to this value to create " +
+					"the full record path. It is usually omitted, as CDS records are published at the zone apex.",
+			},
+			"fqdn": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					dnsvalidator.IsRecordFQDNValid(),
+				},
+				Description: "The fully qualified domain name of the record set, as an alternative to `zone` and `name`. " +
+					"The enclosing zone is discovered by looking up SOA records and `zone` and `name` are computed " +
+					"from it. It must include the trailing dot.",
+			},
+			"ttl": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(3600),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "The TTL of the record set. Defaults to `3600`.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Always set to the fully qualified domain name of the record set",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"cds": dsRecordSetBlock("CDS"),
+		},
+	}
+}
+
+func (d *dnsCDSRecordSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*DNSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DNSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *dnsCDSRecordSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resourceDnsModifyPlan_framework(ctx, d.client, dns.TypeCDS, req, resp)
+}
+
+func (d *dnsCDSRecordSetResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = recordIdentitySchema()
+}
+
+func (d *dnsCDSRecordSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan cdsRecordSetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, configDiags := resourceDnsConfig_framework(plan.Zone, plan.Name, plan.FQDN, d.client)
+	resp.Diagnostics.Append(configDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeCDS))...)
+
+	fqdn := resourceFQDN_framework(config)
+	plan.ID = types.StringValue(fqdn)
+	plan.Zone = types.StringValue(config.Zone)
+	plan.Name = config.nameValue()
+	plan.FQDN = types.StringValue(fqdn)
+
+	msg := new(dns.Msg)
+	msg.SetUpdate(config.Zone)
+
+	records, diags := dsRecordSetRecords(ctx, fqdn, dns.TypeCDS, plan.TTL.ValueInt64(), plan.CDS)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	msg.Insert(records)
+
+	r, err := exchange(msg, true, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating DNS record:", err.Error())
+		return
+	}
+	if r.Rcode != dns.RcodeSuccess {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error updating DNS record: %v", r.Rcode), dns.RcodeToString[r.Rcode])
+		return
+	}
+
+	answers, diags := resourceDnsRead_framework(config, d.client, dns.TypeCDS)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if len(answers) > 0 {
+		var ttl int64
+		plan.CDS, ttl, diags = dsRecordSetValue(ctx, plan.CDS.ElementType(ctx), answers)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		plan.TTL = types.Int64Value(ttl)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	}
+}
+
+func (d *dnsCDSRecordSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state cdsRecordSetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := dnsConfig{
+		Name: state.Name.ValueString(),
+		Zone: state.Zone.ValueString(),
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeCDS))...)
+
+	state.FQDN = types.StringValue(resourceFQDN_framework(config))
+
+	answers, diags := resourceDnsRead_framework(config, d.client, dns.TypeCDS)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if len(answers) > 0 {
+		var ttl int64
+		state.CDS, ttl, diags = dsRecordSetValue(ctx, state.CDS.ElementType(ctx), answers)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		state.TTL = types.Int64Value(ttl)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	} else {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+func (d *dnsCDSRecordSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state cdsRecordSetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := dnsConfig{
+		Name: plan.Name.ValueString(),
+		Zone: plan.Zone.ValueString(),
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeCDS))...)
+
+	fqdn := resourceFQDN_framework(config)
+	state.FQDN = types.StringValue(fqdn)
+
+	if !plan.CDS.Equal(state.CDS) {
+		msg := new(dns.Msg)
+		msg.SetUpdate(plan.Zone.ValueString())
+
+		records, diags := dsRecordSetRecords(ctx, fqdn, dns.TypeCDS, plan.TTL.ValueInt64(), plan.CDS)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Replace the whole record set within a single update
+		msg.RemoveRRset([]dns.RR{rdata.NewEmpty(fqdn, dns.TypeCDS)})
+		msg.Insert(records)
+
+		r, err := exchange(msg, true, d.client)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating DNS record:", err.Error())
+			return
+		}
+		if r.Rcode != dns.RcodeSuccess {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error updating DNS record: %v", r.Rcode),
+				dns.RcodeToString[r.Rcode])
+			return
+		}
+	}
+
+	answers, diags := resourceDnsRead_framework(config, d.client, dns.TypeCDS)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if len(answers) > 0 {
+		var ttl int64
+		state.CDS, ttl, diags = dsRecordSetValue(ctx, state.CDS.ElementType(ctx), answers)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		state.TTL = types.Int64Value(ttl)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	}
+}
+
+func (d *dnsCDSRecordSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state cdsRecordSetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := dnsConfig{
+		Name: state.Name.ValueString(),
+		Zone: state.Zone.ValueString(),
+	}
+
+	resp.Diagnostics.Append(resourceDnsDelete_framework(config, d.client, dns.TypeCDS)...)
+}
+
+func (d *dnsCDSRecordSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, config, diags := resourceDnsImport_framework(ctx, req, dns.TypeCDS, d.client)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fqdn"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), config.Zone)...)
+	if config.Name != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), config.Name)...)
+	}
+}
+
+type cdsRecordSetResourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Zone types.String `tfsdk:"zone"`
+	Name types.String `tfsdk:"name"`
+	FQDN types.String `tfsdk:"fqdn"`
+	CDS  types.Set    `tfsdk:"cds"` //dsBlockConfig
+	TTL  types.Int64  `tfsdk:"ttl"`
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/miekg/dns"
+)
+
+func TestAccDnsCDSRecordSet_Delete(t *testing.T) {
+	resourceName := "dns_cds_record_set.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDnsCDSRecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsCDSRecordSet_delete,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cds.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "cds.*", map[string]string{
+						"key_tag":     "0",
+						"algorithm":   "0",
+						"digest_type": "0",
+						"digest":      "00",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDnsCDSRecordSetDestroy(s *terraform.State) error {
+	return testAccCheckDnsDestroy(s, "dns_cds_record_set", dns.TypeCDS)
+}
+
+var testAccDnsCDSRecordSet_delete = `
+  resource "dns_cds_record_set" "foo" {
+    zone = "example.com."
+    cds {
+      key_tag = 0
+      algorithm = 0
+      digest_type = 0
+      digest = "00"
+    }
+    ttl = 300
+  }`
@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -17,13 +19,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/miekg/dns"
 
+	"github.com/hashicorp/terraform-provider-dns/internal/dnstypes"
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
 	"github.com/hashicorp/terraform-provider-dns/internal/validators/dnsvalidator"
 )
 
 var (
 	_ resource.Resource                = (*dnsCNAMERecordResource)(nil)
 	_ resource.ResourceWithImportState = (*dnsCNAMERecordResource)(nil)
+	_ resource.ResourceWithIdentity    = (*dnsCNAMERecordResource)(nil)
 	_ resource.ResourceWithConfigure   = (*dnsCNAMERecordResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*dnsCNAMERecordResource)(nil)
 )
 
 func NewDnsCNAMERecordResource() resource.Resource {
@@ -43,32 +49,52 @@ func (d *dnsCNAMERecordResource) Schema(ctx context.Context, req resource.Schema
 		Description: "Creates a CNAME type DNS record.",
 		Attributes: map[string]schema.Attribute{
 			"zone": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
 					dnsvalidator.IsZoneNameValid(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("fqdn")),
+					stringvalidator.AlsoRequires(path.MatchRoot("name")),
 				},
-				Description: "DNS zone the record belongs to. It must be an FQDN, that is, include the trailing dot.",
+				Description: "DNS zone the record belongs to. It must be an FQDN, that is, include the trailing dot. " +
+					"Exactly one of `zone` or `fqdn` must be set.",
 			},
 			"name": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
 					dnsvalidator.IsRecordNameValid(),
+					stringvalidator.ConflictsWith(path.MatchRoot("fqdn")),
 				},
 				Description: "The name of the record. The `zone` argument will be appended to this value to create " +
 					"the full record path.",
 			},
-			"cname": schema.StringAttribute{
-				Required: true,
+			"fqdn": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
 				Validators: []validator.String{
-					dnsvalidator.IsZoneNameValid(),
+					dnsvalidator.IsRecordFQDNValid(),
 				},
-				Description: "The canonical name this record will point to.",
+				Description: "The fully qualified domain name of the record, as an alternative to `zone` and `name`. " +
+					"The enclosing zone is discovered by looking up SOA records and `zone` and `name` are computed " +
+					"from it. It must include the trailing dot.",
+			},
+			"cname": schema.StringAttribute{
+				CustomType:  dnstypes.HostnameType{},
+				Required:    true,
+				Description: "The canonical name this record will point to. The trailing dot may be omitted.",
 			},
 			"ttl": schema.Int64Attribute{
 				Optional: true,
@@ -106,6 +132,14 @@ func (d *dnsCNAMERecordResource) Configure(ctx context.Context, req resource.Con
 	d.client = client
 }
 
+func (d *dnsCNAMERecordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resourceDnsModifyPlan_framework(ctx, d.client, dns.TypeCNAME, req, resp)
+}
+
+func (d *dnsCNAMERecordResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = recordIdentitySchema()
+}
+
 func (d *dnsCNAMERecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan cnameRecordResourceModel
 
@@ -114,23 +148,24 @@ func (d *dnsCNAMERecordResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	config := dnsConfig{
-		Name: plan.Name.ValueString(),
-		Zone: plan.Zone.ValueString(),
+	config, configDiags := resourceDnsConfig_framework(plan.Zone, plan.Name, plan.FQDN, d.client)
+	resp.Diagnostics.Append(configDiags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeCNAME))...)
+
 	rec_fqdn := resourceFQDN_framework(config)
 	plan.ID = types.StringValue(rec_fqdn)
+	plan.Zone = types.StringValue(config.Zone)
+	plan.Name = config.nameValue()
+	plan.FQDN = types.StringValue(rec_fqdn)
 
 	msg := new(dns.Msg)
-	msg.SetUpdate(plan.Zone.ValueString())
+	msg.SetUpdate(config.Zone)
 
-	rrStrInsert := fmt.Sprintf("%s %d CNAME %s", rec_fqdn, plan.TTL.ValueInt64(), plan.CNAME.ValueString())
-
-	rr_insert, err := dns.NewRR(rrStrInsert)
-	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Error reading DNS record (%s):", rrStrInsert), err.Error())
-		return
-	}
+	rr_insert := rdata.NewCNAME(rec_fqdn, plan.TTL.ValueInt64(), plan.CNAME.ValueString())
 	msg.Insert([]dns.RR{rr_insert})
 
 	r, err := exchange(msg, true, d.client)
@@ -155,13 +190,13 @@ func (d *dnsCNAMERecordResource) Create(ctx context.Context, req resource.Create
 			return
 		}
 		record := answers[0]
-		cname, ttl, err := getCnameVal(record)
+		cname, ttl, err := rdata.CNAME(record)
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating DNS record:", err.Error())
 			return
 		}
 
-		plan.CNAME = types.StringValue(cname)
+		plan.CNAME = dnstypes.NewHostnameValue(cname)
 		plan.TTL = types.Int64Value(int64(ttl))
 
 		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -182,6 +217,10 @@ func (d *dnsCNAMERecordResource) Read(ctx context.Context, req resource.ReadRequ
 		Zone: state.Zone.ValueString(),
 	}
 
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeCNAME))...)
+
+	state.FQDN = types.StringValue(resourceFQDN_framework(config))
+
 	answers, diags := resourceDnsRead_framework(config, d.client, dns.TypeCNAME)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
@@ -194,13 +233,13 @@ func (d *dnsCNAMERecordResource) Read(ctx context.Context, req resource.ReadRequ
 			return
 		}
 		record := answers[0]
-		cname, ttl, err := getCnameVal(record)
+		cname, ttl, err := rdata.CNAME(record)
 		if err != nil {
 			resp.Diagnostics.AddError("Error querying DNS record:", err.Error())
 			return
 		}
 
-		state.CNAME = types.StringValue(cname)
+		state.CNAME = dnstypes.NewHostnameValue(cname)
 		state.TTL = types.Int64Value(int64(ttl))
 
 		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -226,28 +265,26 @@ func (d *dnsCNAMERecordResource) Update(ctx context.Context, req resource.Update
 		Name: plan.Name.ValueString(),
 		Zone: plan.Zone.ValueString(),
 	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeCNAME))...)
+
 	rec_fqdn := resourceFQDN_framework(config)
+	state.FQDN = types.StringValue(rec_fqdn)
 
 	msg := new(dns.Msg)
 	msg.SetUpdate(plan.Zone.ValueString())
 
-	if !plan.CNAME.Equal(state.CNAME) {
-
-		rrStrRemove := fmt.Sprintf("%s %d CNAME %s", rec_fqdn, plan.TTL.ValueInt64(), state.CNAME.ValueString())
+	equal, equalDiags := plan.CNAME.StringSemanticEquals(ctx, state.CNAME)
+	resp.Diagnostics.Append(equalDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		rr_remove, err := dns.NewRR(rrStrRemove)
-		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Error reading DNS record (%s):", rrStrRemove), err.Error())
-			return
-		}
+	if !equal {
 
-		rrStrInsert := fmt.Sprintf("%s %d CNAME %s", rec_fqdn, plan.TTL.ValueInt64(), plan.CNAME.ValueString())
+		rr_remove := rdata.NewCNAME(rec_fqdn, plan.TTL.ValueInt64(), state.CNAME.ValueString())
 
-		rr_insert, err := dns.NewRR(rrStrInsert)
-		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Error reading DNS record (%s):", rrStrInsert), err.Error())
-			return
-		}
+		rr_insert := rdata.NewCNAME(rec_fqdn, plan.TTL.ValueInt64(), plan.CNAME.ValueString())
 
 		msg.Remove([]dns.RR{rr_remove})
 		msg.Insert([]dns.RR{rr_insert})
@@ -275,13 +312,13 @@ func (d *dnsCNAMERecordResource) Update(ctx context.Context, req resource.Update
 			return
 		}
 		record := answers[0]
-		cname, ttl, err := getCnameVal(record)
+		cname, ttl, err := rdata.CNAME(record)
 		if err != nil {
 			resp.Diagnostics.AddError("Error querying DNS record:", err.Error())
 			return
 		}
 
-		state.CNAME = types.StringValue(cname)
+		state.CNAME = dnstypes.NewHostnameValue(cname)
 		state.TTL = types.Int64Value(int64(ttl))
 
 		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -307,23 +344,25 @@ func (d *dnsCNAMERecordResource) Delete(ctx context.Context, req resource.Delete
 func (d *dnsCNAMERecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var state cnameRecordResourceModel
 
-	config, diags := resourceDnsImport_framework(req.ID, d.client)
+	id, config, diags := resourceDnsImport_framework(ctx, req, dns.TypeCNAME, d.client)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
 	}
 
-	state.ID = types.StringValue(req.ID)
-	state.Name = types.StringValue(config.Name)
+	state.ID = types.StringValue(id)
+	state.FQDN = types.StringValue(id)
+	state.Name = config.nameValue()
 	state.Zone = types.StringValue(config.Zone)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 type cnameRecordResourceModel struct {
-	ID    types.String `tfsdk:"id"`
-	Zone  types.String `tfsdk:"zone"`
-	Name  types.String `tfsdk:"name"`
-	CNAME types.String `tfsdk:"cname"`
-	TTL   types.Int64  `tfsdk:"ttl"`
+	ID    types.String      `tfsdk:"id"`
+	Zone  types.String      `tfsdk:"zone"`
+	Name  types.String      `tfsdk:"name"`
+	FQDN  types.String      `tfsdk:"fqdn"`
+	CNAME dnstypes.Hostname `tfsdk:"cname"`
+	TTL   types.Int64       `tfsdk:"ttl"`
 }
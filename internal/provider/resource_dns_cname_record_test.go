@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -108,6 +109,117 @@ func TestAccDnsCnameRecord_Basic_Upgrade(t *testing.T) {
 	})
 }
 
+func TestAccDnsCnameRecord_FQDN(t *testing.T) {
+	resourceName := "dns_cname_record.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDnsCnameRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsCnameRecord_fqdn,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "zone", "example.com."),
+					resource.TestCheckResourceAttr(resourceName, "name", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "fqdn", "foo.example.com."),
+					resource.TestCheckResourceAttr(resourceName, "cname", "bar.example.com."),
+				),
+			},
+			{
+				Config: testAccDnsCnameRecord_basic,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDnsCnameRecord_Hostname(t *testing.T) {
+	resourceName := "dns_cname_record.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDnsCnameRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsCnameRecord_hostname,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cname", "Bar.Example.com"),
+				),
+			},
+			{
+				Config: testAccDnsCnameRecord_hostname,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccDnsCnameRecord_IDN(t *testing.T) {
+	resourceName := "dns_cname_record.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDnsCnameRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsCnameRecord_idn,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "bücher"),
+					resource.TestCheckResourceAttr(resourceName, "fqdn", "bücher.example.com."),
+					resource.TestCheckResourceAttr(resourceName, "cname", "straße.example.com."),
+				),
+			},
+			{
+				Config: testAccDnsCnameRecord_idn,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cname"},
+			},
+		},
+	})
+}
+
+func TestAccDnsCnameRecord_Conflict(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDnsCnameRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsCnameRecord_conflictTXT,
+			},
+			{
+				Config:      testAccDnsCnameRecord_conflict,
+				ExpectError: regexp.MustCompile("conflict.example.com. already has TXT records"),
+			},
+		},
+	})
+}
+
 func testAccCheckDnsCnameRecordDestroy(s *terraform.State) error {
 	return testAccCheckDnsDestroy(s, "dns_cname_record", dns.TypeCNAME)
 }
@@ -127,3 +239,47 @@ var testAccDnsCnameRecord_update = `
     cname = "baz.example.com."
     ttl = 300
   }`
+
+var testAccDnsCnameRecord_fqdn = `
+  resource "dns_cname_record" "foo" {
+    fqdn = "foo.example.com."
+    cname = "bar.example.com."
+    ttl = 300
+  }`
+
+var testAccDnsCnameRecord_hostname = `
+  resource "dns_cname_record" "foo" {
+    zone = "example.com."
+    name = "foo"
+    cname = "Bar.Example.com"
+    ttl = 300
+  }`
+
+var testAccDnsCnameRecord_idn = `
+  resource "dns_cname_record" "foo" {
+    zone = "example.com."
+    name = "bücher"
+    cname = "straße.example.com."
+    ttl = 300
+  }`
+
+var testAccDnsCnameRecord_conflictTXT = `
+  provider "dns" {
+    cname_conflicts = "error"
+  }
+
+  resource "dns_txt_record_set" "foo" {
+    zone = "example.com."
+    name = "conflict"
+    txt = ["foo"]
+    ttl = 300
+  }`
+
+var testAccDnsCnameRecord_conflict = testAccDnsCnameRecord_conflictTXT + `
+
+  resource "dns_cname_record" "foo" {
+    zone = "example.com."
+    name = "conflict"
+    cname = "bar.example.com."
+    ttl = 300
+  }`
@@ -0,0 +1,751 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/dnstypes"
+	"github.com/hashicorp/terraform-provider-dns/internal/idn"
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
+	"github.com/hashicorp/terraform-provider-dns/internal/validators/dnsvalidator"
+)
+
+var (
+	_ resource.Resource                   = (*dnsDelegationResource)(nil)
+	_ resource.ResourceWithImportState    = (*dnsDelegationResource)(nil)
+	_ resource.ResourceWithConfigure      = (*dnsDelegationResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*dnsDelegationResource)(nil)
+)
+
+func NewDnsDelegationResource() resource.Resource {
+	return &dnsDelegationResource{}
+}
+
+type dnsDelegationResource struct {
+	client *DNSClient
+}
+
+func (d *dnsDelegationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_delegation"
+}
+
+func (d *dnsDelegationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Delegates a child zone from its parent zone, managing the NS record set of the child zone " +
+			"along with the A and AAAA glue records of the nameservers within it in a single update.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					dnsvalidator.IsZoneNameValid(),
+				},
+				Description: "DNS zone the child zone is delegated from. It must be an FQDN, that is, include the " +
+					"trailing dot.",
+			},
+			"child": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					dnsvalidator.IsRecordNameValid(),
+				},
+				Description: "The name of the delegated zone. The `zone` argument will be appended to this value to " +
+					"create the full zone name.",
+			},
+			"ttl": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(3600),
+				Description: "The TTL of the NS and glue records. Defaults to `3600`.",
+			},
+			"verify": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "Verify before updating the delegation that each nameserver answers authoritatively " +
+					"for the SOA record of the child zone, on port 53 of its glue addresses or of the addresses " +
+					"it resolves to. Defaults to `false`.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Always set to the fully qualified domain name of the delegated zone.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"nameserver": schema.SetNestedBlock{
+				Description: "Can be specified multiple times for each nameserver of the child zone.",
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							CustomType:  dnstypes.HostnameType{},
+							Required:    true,
+							Description: "The host name of the nameserver. The trailing dot may be omitted.",
+						},
+						"addresses": schema.SetAttribute{
+							ElementType: dnstypes.IPAddressType{},
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+							Description: "The IPv4 and IPv6 addresses of the nameserver, published as glue records. " +
+								"They are required for nameservers within the child zone, and not allowed for " +
+								"other nameservers.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dnsDelegationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*DNSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DNSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *dnsDelegationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config delegationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Zone.IsUnknown() || config.Child.IsUnknown() || config.Nameservers.IsNull() || config.Nameservers.IsUnknown() {
+		return
+	}
+
+	fqdn := resourceFQDN_framework(dnsConfig{
+		Name: config.Child.ValueString(),
+		Zone: config.Zone.ValueString(),
+	})
+
+	var nameservers []delegationNameserverConfig
+	resp.Diagnostics.Append(config.Nameservers.ElementsAs(ctx, &nameservers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, nameserver := range nameservers {
+		if nameserver.Name.IsUnknown() || nameserver.Addresses.IsUnknown() {
+			continue
+		}
+
+		name := nameserver.Name.ValueString()
+		inBailiwick := delegationInBailiwick(fqdn, name)
+
+		if inBailiwick && nameserver.Addresses.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("nameserver"),
+				"Missing Glue Addresses",
+				fmt.Sprintf("Nameserver %s is within the delegated zone %s, so its addresses must be set as glue records.", name, fqdn),
+			)
+		}
+
+		if !inBailiwick && !nameserver.Addresses.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("nameserver"),
+				"Out-of-Bailiwick Glue Addresses",
+				fmt.Sprintf("Nameserver %s is not within the delegated zone %s, so its addresses cannot be set as glue "+
+					"records. Manage them with record resources in their own zone.", name, fqdn),
+			)
+		}
+	}
+}
+
+func (d *dnsDelegationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan delegationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := dnsConfig{
+		Name: plan.Child.ValueString(),
+		Zone: plan.Zone.ValueString(),
+	}
+	fqdn := resourceFQDN_framework(config)
+	plan.ID = types.StringValue(fqdn)
+
+	var nameservers []delegationNameserverConfig
+	resp.Diagnostics.Append(plan.Nameservers.ElementsAs(ctx, &nameservers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Verify.ValueBool() {
+		resp.Diagnostics.Append(delegationVerify(ctx, fqdn, nameservers, d.client)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	records, diags := delegationRecords(ctx, fqdn, plan.TTL.ValueInt64(), nameservers)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	msg := new(dns.Msg)
+	msg.SetUpdate(config.Zone)
+	msg.Insert(records)
+
+	r, err := exchange(msg, true, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating DNS record:", err.Error())
+		return
+	}
+	if r.Rcode != dns.RcodeSuccess {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error updating DNS record: %v", r.Rcode), dns.RcodeToString[r.Rcode])
+		return
+	}
+
+	ns, glue, diags := delegationRead(config, d.client)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if len(ns) > 0 {
+		var ttl int64
+		plan.Nameservers, ttl, diags = delegationNameserversValue(ctx, fqdn, nameservers, ns, glue)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		plan.TTL = types.Int64Value(ttl)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	}
+}
+
+func (d *dnsDelegationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state delegationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := dnsConfig{
+		Name: state.Child.ValueString(),
+		Zone: state.Zone.ValueString(),
+	}
+	fqdn := resourceFQDN_framework(config)
+
+	var prior []delegationNameserverConfig
+	if !state.Nameservers.IsNull() {
+		resp.Diagnostics.Append(state.Nameservers.ElementsAs(ctx, &prior, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	ns, glue, diags := delegationRead(config, d.client)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if len(ns) > 0 {
+		var ttl int64
+		state.Nameservers, ttl, diags = delegationNameserversValue(ctx, fqdn, prior, ns, glue)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		state.TTL = types.Int64Value(ttl)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	} else {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+func (d *dnsDelegationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state delegationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := dnsConfig{
+		Name: plan.Child.ValueString(),
+		Zone: plan.Zone.ValueString(),
+	}
+	fqdn := resourceFQDN_framework(config)
+
+	var planNameservers, stateNameservers []delegationNameserverConfig
+	resp.Diagnostics.Append(plan.Nameservers.ElementsAs(ctx, &planNameservers, false)...)
+	resp.Diagnostics.Append(state.Nameservers.ElementsAs(ctx, &stateNameservers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Nameservers.Equal(state.Nameservers) || !plan.TTL.Equal(state.TTL) {
+		if plan.Verify.ValueBool() {
+			resp.Diagnostics.Append(delegationVerify(ctx, fqdn, planNameservers, d.client)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		records, diags := delegationRecords(ctx, fqdn, plan.TTL.ValueInt64(), planNameservers)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Replace the NS and glue records within a single update
+		msg := new(dns.Msg)
+		msg.SetUpdate(config.Zone)
+		msg.RemoveRRset(delegationRRsets(fqdn, stateNameservers, planNameservers))
+		msg.Insert(records)
+
+		r, err := exchange(msg, true, d.client)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating DNS record:", err.Error())
+			return
+		}
+		if r.Rcode != dns.RcodeSuccess {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error updating DNS record: %v", r.Rcode),
+				dns.RcodeToString[r.Rcode])
+			return
+		}
+	}
+
+	ns, glue, diags := delegationRead(config, d.client)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if len(ns) > 0 {
+		var ttl int64
+		plan.Nameservers, ttl, diags = delegationNameserversValue(ctx, fqdn, planNameservers, ns, glue)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		plan.TTL = types.Int64Value(ttl)
+		plan.ID = types.StringValue(fqdn)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	}
+}
+
+func (d *dnsDelegationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state delegationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameservers []delegationNameserverConfig
+	resp.Diagnostics.Append(state.Nameservers.ElementsAs(ctx, &nameservers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fqdn := resourceFQDN_framework(dnsConfig{
+		Name: state.Child.ValueString(),
+		Zone: state.Zone.ValueString(),
+	})
+
+	msg := new(dns.Msg)
+	msg.SetUpdate(state.Zone.ValueString())
+	msg.RemoveRRset(delegationRRsets(fqdn, nameservers))
+
+	r, err := exchange(msg, true, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting DNS record:", err.Error())
+		return
+	}
+	if r.Rcode != dns.RcodeSuccess {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error deleting DNS record: %v", r.Rcode), dns.RcodeToString[r.Rcode])
+	}
+}
+
+func (d *dnsDelegationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	config, diags := resourceDnsDiscover_framework(req.ID, d.client)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if config.Name == "" {
+		resp.Diagnostics.AddError("Error importing DNS delegation:",
+			fmt.Sprintf("%s is the apex of zone %s, not a delegation from its parent zone. The server may also be "+
+				"authoritative for the delegated zone.", req.ID, config.Zone))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), config.Zone)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("child"), config.Name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("verify"), false)...)
+}
+
+type delegationResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Zone        types.String `tfsdk:"zone"`
+	Child       types.String `tfsdk:"child"`
+	TTL         types.Int64  `tfsdk:"ttl"`
+	Verify      types.Bool   `tfsdk:"verify"`
+	Nameservers types.Set    `tfsdk:"nameserver"` //delegationNameserverConfig
+}
+
+type delegationNameserverConfig struct {
+	Name      dnstypes.Hostname `tfsdk:"name"`
+	Addresses types.Set         `tfsdk:"addresses"` //dnstypes.IPAddress
+}
+
+// delegationInBailiwick reports whether the nameserver name is within the
+// delegated zone fqdn, so it can only be resolved with glue records.
+func delegationInBailiwick(fqdn, name string) bool {
+	zone, err := idn.ToASCII(fqdn)
+	if err != nil {
+		zone = fqdn
+	}
+	host, err := idn.ToASCII(dns.Fqdn(name))
+	if err != nil {
+		host = dns.Fqdn(name)
+	}
+
+	return dns.IsSubDomain(zone, host)
+}
+
+// delegationRecords returns the NS records of the delegated zone fqdn and the
+// glue records of its nameservers.
+func delegationRecords(ctx context.Context, fqdn string, ttl int64, nameservers []delegationNameserverConfig) ([]dns.RR, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var records []dns.RR
+
+	for _, nameserver := range nameservers {
+		name := dns.Fqdn(nameserver.Name.ValueString())
+		records = append(records, rdata.NewNS(fqdn, ttl, name))
+
+		if nameserver.Addresses.IsNull() {
+			continue
+		}
+
+		var addresses []dnstypes.IPAddress
+		diags.Append(nameserver.Addresses.ElementsAs(ctx, &addresses, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for _, address := range addresses {
+			var record dns.RR
+			var err error
+			if address.ValueIP().To4() != nil {
+				record, err = rdata.NewA(name, ttl, address.ValueString())
+			} else {
+				record, err = rdata.NewAAAA(name, ttl, address.ValueString())
+			}
+			if err != nil {
+				diags.AddError("Error building DNS record:", err.Error())
+				return nil, diags
+			}
+			records = append(records, record)
+		}
+	}
+
+	return records, diags
+}
+
+// delegationRRsets returns the NS record set of the delegated zone fqdn and
+// the A and AAAA record sets of the nameservers within it, for removal.
+func delegationRRsets(fqdn string, nameservers ...[]delegationNameserverConfig) []dns.RR {
+	rrsets := []dns.RR{rdata.NewEmpty(fqdn, dns.TypeNS)}
+
+	seen := make(map[string]bool)
+	for _, list := range nameservers {
+		for _, nameserver := range list {
+			name := dns.Fqdn(nameserver.Name.ValueString())
+			if seen[dns.CanonicalName(name)] || !delegationInBailiwick(fqdn, name) {
+				continue
+			}
+			seen[dns.CanonicalName(name)] = true
+
+			rrsets = append(rrsets, rdata.NewEmpty(name, dns.TypeA), rdata.NewEmpty(name, dns.TypeAAAA))
+		}
+	}
+
+	return rrsets
+}
+
+// delegationRead returns the NS records of the delegated zone and the glue
+// records returned along with them. The parent zone answers the query with a
+// referral, with the NS records in the authority section and the glue records
+// in the additional section.
+func delegationRead(config dnsConfig, client *DNSClient) ([]dns.RR, []dns.RR, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	fqdn := resourceFQDN_framework(config)
+
+	owner, err := idn.ToASCII(fqdn)
+	if err != nil {
+		owner = fqdn
+	}
+
+	msg := new(dns.Msg)
+	msg.SetQuestion(fqdn, dns.TypeNS)
+
+	r, err := exchange(msg, true, client)
+	if err != nil {
+		diags.AddError("Error querying DNS record:", err.Error())
+		return nil, nil, diags
+	}
+	switch r.Rcode {
+	case dns.RcodeSuccess:
+	case dns.RcodeNameError:
+		return nil, nil, nil
+	default:
+		diags.AddError(fmt.Sprintf("Error querying DNS record: %v", r.Rcode), dns.RcodeToString[r.Rcode])
+		return nil, nil, diags
+	}
+
+	ns, glue, err := delegationReferral(owner, r)
+	if err != nil {
+		diags.AddError("Error querying DNS record:", err.Error())
+		return nil, nil, diags
+	}
+
+	return ns, glue, nil
+}
+
+// delegationReferral returns the NS and glue records of the referral to the
+// zone owner in r, the response of the server to a query for its NS records
+// without recursion. A server which is also authoritative for the delegated
+// zone answers with the NS records of its apex instead, which may differ from
+// the delegation. An authoritative answer without them is from the parent
+// zone, and means that there is no delegation.
+func delegationReferral(owner string, r *dns.Msg) ([]dns.RR, []dns.RR, error) {
+	for _, record := range r.Answer {
+		if record.Header().Rrtype == dns.TypeNS && dns.CanonicalName(record.Header().Name) == dns.CanonicalName(owner) {
+			return nil, nil, fmt.Errorf("the server is authoritative for %s, so the delegation cannot be read "+
+				"from its parent zone: use a server which is only authoritative for the parent zone", owner)
+		}
+	}
+
+	if r.Authoritative {
+		return nil, nil, nil
+	}
+
+	var ns, glue []dns.RR
+	for _, record := range r.Ns {
+		if record.Header().Rrtype == dns.TypeNS && dns.CanonicalName(record.Header().Name) == dns.CanonicalName(owner) {
+			ns = append(ns, record)
+		}
+	}
+	if len(ns) == 0 {
+		return nil, nil, fmt.Errorf("the server did not answer authoritatively or with a referral to %s", owner)
+	}
+
+	for _, record := range r.Extra {
+		switch record.Header().Rrtype {
+		case dns.TypeA, dns.TypeAAAA:
+			glue = append(glue, record)
+		}
+	}
+
+	return ns, glue, nil
+}
+
+// delegationNameserversValue returns the set of delegationNameserverConfig
+// elements for the NS and glue records of the delegated zone fqdn, along with
+// the lowest TTL of the NS records. Names and addresses equivalent to those
+// of prior keep their spelling.
+func delegationNameserversValue(ctx context.Context, fqdn string, prior []delegationNameserverConfig, ns, glue []dns.RR) (types.Set, int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var ttl sort.IntSlice
+
+	elemType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":      dnstypes.HostnameType{},
+		"addresses": types.SetType{ElemType: dnstypes.IPAddressType{}},
+	}}
+
+	var blocks []delegationNameserverConfig
+	for _, record := range ns {
+		target, t, err := rdata.NS(record)
+		if err != nil {
+			diags.AddError("Error querying DNS record:", err.Error())
+			return types.SetNull(elemType), 0, diags
+		}
+		ttl = append(ttl, t)
+
+		block := delegationNameserverConfig{
+			Name:      dnstypes.NewHostnameValue(target),
+			Addresses: types.SetNull(dnstypes.IPAddressType{}),
+		}
+
+		var priorAddresses []dnstypes.IPAddress
+		for _, p := range prior {
+			if dns.CanonicalName(dns.Fqdn(p.Name.ValueString())) == dns.CanonicalName(target) {
+				block.Name = p.Name
+				if !p.Addresses.IsNull() {
+					diags.Append(p.Addresses.ElementsAs(ctx, &priorAddresses, false)...)
+				}
+			}
+		}
+
+		if delegationInBailiwick(fqdn, target) {
+			var addresses []dnstypes.IPAddress
+			for _, record := range glue {
+				if dns.CanonicalName(record.Header().Name) != dns.CanonicalName(target) {
+					continue
+				}
+
+				var address string
+				if record.Header().Rrtype == dns.TypeA {
+					address, _, err = rdata.A(record)
+				} else {
+					address, _, err = rdata.AAAA(record)
+				}
+				if err != nil {
+					diags.AddError("Error querying DNS record:", err.Error())
+					return types.SetNull(elemType), 0, diags
+				}
+
+				value := dnstypes.NewIPAddressValue(address)
+				for _, p := range priorAddresses {
+					if p.ValueIP().Equal(value.ValueIP()) {
+						value = p
+					}
+				}
+				addresses = append(addresses, value)
+			}
+
+			if len(addresses) > 0 {
+				var convertDiags diag.Diagnostics
+				block.Addresses, convertDiags = types.SetValueFrom(ctx, dnstypes.IPAddressType{}, addresses)
+				diags.Append(convertDiags...)
+			}
+		}
+
+		blocks = append(blocks, block)
+	}
+	sort.Sort(ttl)
+
+	if diags.HasError() {
+		return types.SetNull(elemType), 0, diags
+	}
+
+	set, convertDiags := types.SetValueFrom(ctx, elemType, blocks)
+	diags.Append(convertDiags...)
+
+	return set, int64(ttl[0]), diags
+}
+
+// delegationVerify checks that each nameserver answers authoritatively for the
+// SOA record of the delegated zone fqdn, so the delegation is not lame.
+func delegationVerify(ctx context.Context, fqdn string, nameservers []delegationNameserverConfig, client *DNSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	zone, err := idn.ToASCII(fqdn)
+	if err != nil {
+		diags.AddError("Error verifying DNS delegation:", err.Error())
+		return diags
+	}
+
+	for _, nameserver := range nameservers {
+		name := dns.Fqdn(nameserver.Name.ValueString())
+
+		var addresses []string
+		if !nameserver.Addresses.IsNull() {
+			var values []dnstypes.IPAddress
+			diags.Append(nameserver.Addresses.ElementsAs(ctx, &values, false)...)
+			if diags.HasError() {
+				return diags
+			}
+			for _, value := range values {
+				addresses = append(addresses, value.ValueIP().String())
+			}
+		} else {
+			addresses, err = net.DefaultResolver.LookupHost(ctx, name)
+			if err != nil {
+				diags.AddError("Error verifying DNS delegation:",
+					fmt.Sprintf("Unable to resolve nameserver %s: %s", name, err))
+				continue
+			}
+		}
+
+		for _, address := range addresses {
+			msg := new(dns.Msg)
+			msg.SetQuestion(zone, dns.TypeSOA)
+			msg.RecursionDesired = false
+
+			c := &dns.Client{Net: client.transport, Timeout: client.c.Timeout}
+			r, _, err := c.ExchangeContext(ctx, msg, net.JoinHostPort(address, "53"))
+			if err != nil {
+				diags.AddError("Error verifying DNS delegation:",
+					fmt.Sprintf("Nameserver %s (%s) did not answer the SOA query for %s: %s", name, address, fqdn, err))
+				continue
+			}
+
+			authoritative := false
+			if r.Rcode == dns.RcodeSuccess && r.Authoritative {
+				for _, record := range r.Answer {
+					if record.Header().Rrtype == dns.TypeSOA && dns.CanonicalName(record.Header().Name) == dns.CanonicalName(zone) {
+						authoritative = true
+					}
+				}
+			}
+			if !authoritative {
+				diags.AddError("Error verifying DNS delegation:",
+					fmt.Sprintf("Nameserver %s (%s) does not answer authoritatively for the SOA record of %s.", name, address, fqdn))
+			}
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,236 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/miekg/dns"
+)
+
+func TestDelegationReferral(t *testing.T) {
+	rr := func(s string) dns.RR {
+		record, err := dns.NewRR(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return record
+	}
+
+	ns := rr("child.example.com. 300 IN NS ns.child.example.com.")
+	glue := rr("ns.child.example.com. 300 IN A 192.0.2.53")
+	soa := rr("example.com. 300 IN SOA ns.example.com. hostmaster.example.com. 1 3600 600 86400 300")
+
+	testCases := map[string]struct {
+		msg          *dns.Msg
+		expectedNS   int
+		expectedGlue int
+		err          bool
+	}{
+		"referral": {
+			msg:          &dns.Msg{Ns: []dns.RR{ns}, Extra: []dns.RR{glue}},
+			expectedNS:   1,
+			expectedGlue: 1,
+		},
+		"child-zone": {
+			msg: &dns.Msg{MsgHdr: dns.MsgHdr{Authoritative: true}, Answer: []dns.RR{ns}, Extra: []dns.RR{glue}},
+			err: true,
+		},
+		"no-delegation": {
+			msg: &dns.Msg{MsgHdr: dns.MsgHdr{Authoritative: true}, Ns: []dns.RR{soa}},
+		},
+		"not-authoritative": {
+			msg: &dns.Msg{Ns: []dns.RR{soa}},
+			err: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ns, glue, err := delegationReferral("CHILD.example.com.", testCase.msg)
+			if testCase.err {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(ns) != testCase.expectedNS || len(glue) != testCase.expectedGlue {
+				t.Errorf("expected %d NS and %d glue records, got %d and %d", testCase.expectedNS, testCase.expectedGlue, len(ns), len(glue))
+			}
+		})
+	}
+}
+
+func TestAccDnsDelegation_Basic(t *testing.T) {
+	resourceName := "dns_delegation.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDnsDelegationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsDelegation_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "delegated.example.com."),
+					resource.TestCheckResourceAttr(resourceName, "nameserver.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "nameserver.*", map[string]string{
+						"name":        "ns1.delegated.example.com.",
+						"addresses.#": "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "nameserver.*", map[string]string{
+						"name": "ns.testdns.co.uk.",
+					}),
+					testAccCheckDnsDelegationGlue("ns1.delegated.example.com.", dns.TypeA, 1),
+					testAccCheckDnsDelegationGlue("ns1.delegated.example.com.", dns.TypeAAAA, 1),
+				),
+			},
+			{
+				Config: testAccDnsDelegation_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "nameserver.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "nameserver.*", map[string]string{
+						"name":        "ns2.delegated.example.com.",
+						"addresses.#": "1",
+					}),
+					testAccCheckDnsDelegationGlue("ns1.delegated.example.com.", dns.TypeA, 0),
+					testAccCheckDnsDelegationGlue("ns1.delegated.example.com.", dns.TypeAAAA, 0),
+					testAccCheckDnsDelegationGlue("ns2.delegated.example.com.", dns.TypeA, 1),
+				),
+			},
+			{
+				PreConfig: func() { testRemoveRecord(t, "NS", "delegated") },
+				Config:    testAccDnsDelegation_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "nameserver.#", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           "delegated.example.com.",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"verify"},
+			},
+		},
+	})
+}
+
+func TestAccDnsDelegation_Glue(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDnsDelegation_outOfBailiwick,
+				ExpectError: regexp.MustCompile("Out-of-Bailiwick Glue Addresses"),
+			},
+			{
+				Config:      testAccDnsDelegation_missingGlue,
+				ExpectError: regexp.MustCompile("Missing Glue Addresses"),
+			},
+		},
+	})
+}
+
+func testAccCheckDnsDelegationGlue(name string, rrType uint16, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		msg := new(dns.Msg)
+		msg.SetQuestion("delegated.example.com.", dns.TypeNS)
+		r, err := exchange(msg, false, dnsClient)
+		if err != nil {
+			return fmt.Errorf("Error querying DNS record: %s", err)
+		}
+
+		count := 0
+		for _, record := range r.Extra {
+			if record.Header().Name == name && record.Header().Rrtype == rrType {
+				count++
+			}
+		}
+		if count != expected {
+			return fmt.Errorf("expected %d %s glue records for %s, got %d", expected, dns.TypeToString[rrType], name, count)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDnsDelegationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dns_delegation" {
+			continue
+		}
+
+		fqdn := testResourceFQDN(rs.Primary.Attributes["child"], rs.Primary.Attributes["zone"])
+
+		msg := new(dns.Msg)
+		msg.SetQuestion(fqdn, dns.TypeNS)
+		r, err := exchange(msg, false, dnsClient)
+		if err != nil {
+			return fmt.Errorf("Error querying DNS record: %s", err)
+		}
+		for _, record := range append(r.Answer, r.Ns...) {
+			if record.Header().Rrtype == dns.TypeNS && record.Header().Name == fqdn {
+				return fmt.Errorf("DNS delegation still exists: %s", record)
+			}
+		}
+	}
+
+	return nil
+}
+
+var testAccDnsDelegation_basic = `
+  resource "dns_delegation" "foo" {
+    zone = "example.com."
+    child = "delegated"
+    nameserver {
+      name = "ns1.delegated.example.com."
+      addresses = ["192.0.2.1", "2001:db8::1"]
+    }
+    nameserver {
+      name = "ns.testdns.co.uk."
+    }
+    ttl = 300
+  }`
+
+var testAccDnsDelegation_update = `
+  resource "dns_delegation" "foo" {
+    zone = "example.com."
+    child = "delegated"
+    nameserver {
+      name = "ns2.delegated.example.com."
+      addresses = ["192.0.2.2"]
+    }
+    nameserver {
+      name = "ns.testdns.co.uk."
+    }
+    ttl = 300
+  }`
+
+var testAccDnsDelegation_outOfBailiwick = `
+  resource "dns_delegation" "foo" {
+    zone = "example.com."
+    child = "delegated"
+    nameserver {
+      name = "ns.testdns.co.uk."
+      addresses = ["192.0.2.1"]
+    }
+  }`
+
+var testAccDnsDelegation_missingGlue = `
+  resource "dns_delegation" "foo" {
+    zone = "example.com."
+    child = "delegated"
+    nameserver {
+      name = "ns1.delegated.example.com."
+    }
+  }`
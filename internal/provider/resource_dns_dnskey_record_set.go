@@ -0,0 +1,447 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
+	"github.com/hashicorp/terraform-provider-dns/internal/validators/dnsvalidator"
+)
+
+var (
+	_ resource.Resource                = (*dnsDNSKEYRecordSetResource)(nil)
+	_ resource.ResourceWithImportState = (*dnsDNSKEYRecordSetResource)(nil)
+	_ resource.ResourceWithIdentity    = (*dnsDNSKEYRecordSetResource)(nil)
+	_ resource.ResourceWithConfigure   = (*dnsDNSKEYRecordSetResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*dnsDNSKEYRecordSetResource)(nil)
+)
+
+func NewDnsDNSKEYRecordSetResource() resource.Resource {
+	return &dnsDNSKEYRecordSetResource{}
+}
+
+type dnsDNSKEYRecordSetResource struct {
+	client *DNSClient
+}
+
+func (d *dnsDNSKEYRecordSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnskey_record_set"
+}
+
+func (d *dnsDNSKEYRecordSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a DNSKEY type DNS record set, which publishes the public DNSSEC keys of a zone. " +
+			"Servers signing the zone themselves may manage these records on their own.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					dnsvalidator.IsZoneNameValid(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("fqdn")),
+				},
+				Description: "DNS zone the record set belongs to. It must be an FQDN, that is, include the trailing dot. " +
+					"Exactly one of `zone` or `fqdn` must be set.",
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					dnsvalidator.IsRecordNameValid(),
+					stringvalidator.ConflictsWith(path.MatchRoot("fqdn")),
+				},
+				Description: "The name of the record set. The `zone` argument will be appended to this value to create " +
+					"the full record path. It is usually omitted, as DNSKEY records are published at the zone apex.",
+			},
+			"fqdn": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					dnsvalidator.IsRecordFQDNValid(),
+				},
+				Description: "The fully qualified domain name of the record set, as an alternative to `zone` and `name`. " +
+					"The enclosing zone is discovered by looking up SOA records and `zone` and `name` are computed " +
+					"from it. It must include the trailing dot.",
+			},
+			"ttl": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(3600),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "The TTL of the record set. Defaults to `3600`.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Always set to the fully qualified domain name of the record set",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"dnskey": dnskeyRecordSetBlock("DNSKEY"),
+		},
+	}
+}
+
+func (d *dnsDNSKEYRecordSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*DNSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DNSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *dnsDNSKEYRecordSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resourceDnsModifyPlan_framework(ctx, d.client, dns.TypeDNSKEY, req, resp)
+}
+
+func (d *dnsDNSKEYRecordSetResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = recordIdentitySchema()
+}
+
+func (d *dnsDNSKEYRecordSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dnskeyRecordSetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, configDiags := resourceDnsConfig_framework(plan.Zone, plan.Name, plan.FQDN, d.client)
+	resp.Diagnostics.Append(configDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeDNSKEY))...)
+
+	fqdn := resourceFQDN_framework(config)
+	plan.ID = types.StringValue(fqdn)
+	plan.Zone = types.StringValue(config.Zone)
+	plan.Name = config.nameValue()
+	plan.FQDN = types.StringValue(fqdn)
+
+	msg := new(dns.Msg)
+	msg.SetUpdate(config.Zone)
+
+	records, diags := dnskeyRecordSetRecords(ctx, fqdn, dns.TypeDNSKEY, plan.TTL.ValueInt64(), plan.DNSKEY)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	msg.Insert(records)
+
+	r, err := exchange(msg, true, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating DNS record:", err.Error())
+		return
+	}
+	if r.Rcode != dns.RcodeSuccess {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error updating DNS record: %v", r.Rcode), dns.RcodeToString[r.Rcode])
+		return
+	}
+
+	answers, diags := resourceDnsRead_framework(config, d.client, dns.TypeDNSKEY)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if len(answers) > 0 {
+		var ttl int64
+		plan.DNSKEY, ttl, diags = dnskeyRecordSetValue(ctx, plan.DNSKEY.ElementType(ctx), answers)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		plan.TTL = types.Int64Value(ttl)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	}
+}
+
+func (d *dnsDNSKEYRecordSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dnskeyRecordSetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := dnsConfig{
+		Name: state.Name.ValueString(),
+		Zone: state.Zone.ValueString(),
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeDNSKEY))...)
+
+	state.FQDN = types.StringValue(resourceFQDN_framework(config))
+
+	answers, diags := resourceDnsRead_framework(config, d.client, dns.TypeDNSKEY)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if len(answers) > 0 {
+		var ttl int64
+		state.DNSKEY, ttl, diags = dnskeyRecordSetValue(ctx, state.DNSKEY.ElementType(ctx), answers)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		state.TTL = types.Int64Value(ttl)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	} else {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+func (d *dnsDNSKEYRecordSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state dnskeyRecordSetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := dnsConfig{
+		Name: plan.Name.ValueString(),
+		Zone: plan.Zone.ValueString(),
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeDNSKEY))...)
+
+	fqdn := resourceFQDN_framework(config)
+	state.FQDN = types.StringValue(fqdn)
+
+	if !plan.DNSKEY.Equal(state.DNSKEY) {
+		msg := new(dns.Msg)
+		msg.SetUpdate(plan.Zone.ValueString())
+
+		records, diags := dnskeyRecordSetRecords(ctx, fqdn, dns.TypeDNSKEY, plan.TTL.ValueInt64(), plan.DNSKEY)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Replace the whole record set within a single update
+		msg.RemoveRRset([]dns.RR{rdata.NewEmpty(fqdn, dns.TypeDNSKEY)})
+		msg.Insert(records)
+
+		r, err := exchange(msg, true, d.client)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating DNS record:", err.Error())
+			return
+		}
+		if r.Rcode != dns.RcodeSuccess {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error updating DNS record: %v", r.Rcode),
+				dns.RcodeToString[r.Rcode])
+			return
+		}
+	}
+
+	answers, diags := resourceDnsRead_framework(config, d.client, dns.TypeDNSKEY)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if len(answers) > 0 {
+		var ttl int64
+		state.DNSKEY, ttl, diags = dnskeyRecordSetValue(ctx, state.DNSKEY.ElementType(ctx), answers)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		state.TTL = types.Int64Value(ttl)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	}
+}
+
+func (d *dnsDNSKEYRecordSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dnskeyRecordSetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := dnsConfig{
+		Name: state.Name.ValueString(),
+		Zone: state.Zone.ValueString(),
+	}
+
+	resp.Diagnostics.Append(resourceDnsDelete_framework(config, d.client, dns.TypeDNSKEY)...)
+}
+
+func (d *dnsDNSKEYRecordSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, config, diags := resourceDnsImport_framework(ctx, req, dns.TypeDNSKEY, d.client)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fqdn"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), config.Zone)...)
+	if config.Name != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), config.Name)...)
+	}
+}
+
+type dnskeyRecordSetResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Zone   types.String `tfsdk:"zone"`
+	Name   types.String `tfsdk:"name"`
+	FQDN   types.String `tfsdk:"fqdn"`
+	DNSKEY types.Set    `tfsdk:"dnskey"` //dnskeyBlockConfig
+	TTL    types.Int64  `tfsdk:"ttl"`
+}
+
+type dnskeyBlockConfig struct {
+	Flags     types.Int64  `tfsdk:"flags"`
+	Algorithm types.Int64  `tfsdk:"algorithm"`
+	PublicKey types.String `tfsdk:"public_key"`
+}
+
+// dnskeyRecordSetBlock returns the block holding the records of a DNSKEY or
+// CDNSKEY record set resource.
+func dnskeyRecordSetBlock(rrType string) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		Description: "Can be specified multiple times for each " + rrType + " record.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"flags": schema.Int64Attribute{
+					Required: true,
+					Validators: []validator.Int64{
+						int64validator.Between(0, 65535),
+					},
+					Description: "The flags of the key, such as `257` for a key signing key or `256` for a zone signing key.",
+				},
+				"algorithm": schema.Int64Attribute{
+					Required: true,
+					Validators: []validator.Int64{
+						int64validator.Between(0, 255),
+					},
+					Description: "The algorithm number of the key, such as `8` for RSASHA256, `13` for ECDSAP256SHA256 " +
+						"or `15` for ED25519.",
+				},
+				"public_key": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(base64Regexp, "must be Base64-encoded without whitespace"),
+					},
+					Description: "The Base64-encoded public key.",
+				},
+			},
+		},
+	}
+}
+
+// base64Regexp matches Base64-encoded data without whitespace.
+var base64Regexp = regexp.MustCompile(`^(?:[A-Za-z0-9+/]{4})*(?:[A-Za-z0-9+/]{2}==|[A-Za-z0-9+/]{3}=)?$`)
+
+// dnskeyRecordSetRecords returns the records of rrType for the
+// dnskeyBlockConfig elements of set.
+func dnskeyRecordSetRecords(ctx context.Context, fqdn string, rrType uint16, ttl int64, set types.Set) ([]dns.RR, diag.Diagnostics) {
+	var blocks []dnskeyBlockConfig
+
+	diags := set.ElementsAs(ctx, &blocks, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	records := make([]dns.RR, 0, len(blocks))
+	for _, key := range blocks {
+		record, err := rdata.NewDNSKEY(fqdn, rrType, ttl, key.Flags.ValueInt64(), key.Algorithm.ValueInt64(),
+			key.PublicKey.ValueString())
+		if err != nil {
+			diags.AddError("Error building DNS record:", err.Error())
+			return nil, diags
+		}
+
+		records = append(records, record)
+	}
+
+	return records, diags
+}
+
+// dnskeyRecordSetValue returns the set of dnskeyBlockConfig elements for the
+// DNSKEY or CDNSKEY records in answers, along with the lowest TTL of the
+// records.
+func dnskeyRecordSetValue(ctx context.Context, elemType attr.Type, answers []dns.RR) (types.Set, int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var ttl sort.IntSlice
+
+	var blocks []dnskeyBlockConfig
+	for _, record := range answers {
+		flags, algorithm, publicKey, t, err := rdata.DNSKEY(record)
+		if err != nil {
+			diags.AddError("Error querying DNS record:", err.Error())
+			return types.SetNull(elemType), 0, diags
+		}
+		blocks = append(blocks, dnskeyBlockConfig{
+			Flags:     types.Int64Value(flags),
+			Algorithm: types.Int64Value(algorithm),
+			PublicKey: types.StringValue(publicKey),
+		})
+		ttl = append(ttl, t)
+	}
+	sort.Sort(ttl)
+
+	set, convertDiags := types.SetValueFrom(ctx, elemType, blocks)
+	diags.Append(convertDiags...)
+
+	return set, int64(ttl[0]), diags
+}
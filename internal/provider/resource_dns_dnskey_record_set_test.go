@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/miekg/dns"
+)
+
+func TestAccDnsDNSKEYRecordSet_Basic(t *testing.T) {
+	resourceName := "dns_dnskey_record_set.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDnsDNSKEYRecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsDNSKEYRecordSet_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "dnskey.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "dnskey.0.public_key", "dns_dnssec_key.ksk", "public_key"),
+				),
+			},
+			{
+				Config: testAccDnsDNSKEYRecordSet_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "dnskey.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "dnskey.*", map[string]string{
+						"flags":     "256",
+						"algorithm": "15",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDnsDNSKEYRecordSetDestroy(s *terraform.State) error {
+	return testAccCheckDnsDestroy(s, "dns_dnskey_record_set", dns.TypeDNSKEY)
+}
+
+var testAccDnsDNSKEYRecordSet_keys = `
+  resource "dns_dnssec_key" "ksk" {
+    zone = "example.com."
+    algorithm = "ED25519"
+  }
+
+  resource "dns_dnssec_key" "zsk" {
+    zone = "example.com."
+    algorithm = "ED25519"
+    flags = 256
+  }`
+
+var testAccDnsDNSKEYRecordSet_basic = testAccDnsDNSKEYRecordSet_keys + `
+
+  resource "dns_dnskey_record_set" "foo" {
+    zone = "example.com."
+    dnskey {
+      flags = dns_dnssec_key.ksk.flags
+      algorithm = 15
+      public_key = dns_dnssec_key.ksk.public_key
+    }
+    ttl = 300
+  }`
+
+var testAccDnsDNSKEYRecordSet_update = testAccDnsDNSKEYRecordSet_keys + `
+
+  resource "dns_dnskey_record_set" "foo" {
+    zone = "example.com."
+    dnskey {
+      flags = dns_dnssec_key.ksk.flags
+      algorithm = 15
+      public_key = dns_dnssec_key.ksk.public_key
+    }
+    dnskey {
+      flags = dns_dnssec_key.zsk.flags
+      algorithm = 15
+      public_key = dns_dnssec_key.zsk.public_key
+    }
+    ttl = 300
+  }`
@@ -0,0 +1,261 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/dnssec"
+	"github.com/hashicorp/terraform-provider-dns/internal/idn"
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
+	"github.com/hashicorp/terraform-provider-dns/internal/validators/dnsvalidator"
+)
+
+var (
+	_ resource.Resource                   = (*dnsDNSSECKeyResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*dnsDNSSECKeyResource)(nil)
+)
+
+func NewDnsDNSSECKeyResource() resource.Resource {
+	return &dnsDNSSECKeyResource{}
+}
+
+// dnsDNSSECKeyResource generates a DNSSEC key pair. The key only exists in the
+// Terraform state, so no DNS server is involved.
+type dnsDNSSECKeyResource struct{}
+
+func (d *dnsDNSSECKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnssec_key"
+}
+
+func (d *dnsDNSSECKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a DNSSEC key pair and the DNSKEY and DS records for it. The key is generated " +
+			"locally and stored in the Terraform state, no DNS server is contacted.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					dnsvalidator.IsZoneNameValid(),
+				},
+				Description: "DNS zone the key signs, which is the owner name of its DNSKEY record. It must be an " +
+					"FQDN, that is, include the trailing dot.",
+			},
+			"algorithm": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(dnssec.Algorithms()...),
+				},
+				Description: "The key algorithm. Valid values are `ECDSAP256SHA256`, `ED25519` or `RSASHA256`.",
+			},
+			"bits": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1024, 4096),
+				},
+				Description: "The size of the key in bits. Can only be set for `RSASHA256` keys, where it " +
+					"defaults to `2048`.",
+			},
+			"flags": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(int64(dnssec.FlagsKSK)),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.OneOf(int64(dnssec.FlagsKSK), int64(dnssec.FlagsZSK)),
+				},
+				Description: "The flags of the DNSKEY record, `257` for a key signing key or `256` for a zone " +
+					"signing key. Defaults to `257`.",
+			},
+			"public_key": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The Base64-encoded public key, as in the DNSKEY record.",
+			},
+			"private_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The private key in the BIND private key file format.",
+			},
+			"key_tag": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "The key tag of the key.",
+			},
+			"dnskey": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The rdata of the DNSKEY record of the key.",
+			},
+			"ds_sha256": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The rdata of the DS record of the key with a SHA-256 digest, to be published in the " +
+					"parent zone.",
+			},
+			"ds_sha384": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The rdata of the DS record of the key with a SHA-384 digest, to be published in the " +
+					"parent zone.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The base name BIND uses for the files of the key, `K<zone>+<algorithm>+<key tag>`.",
+			},
+		},
+	}
+}
+
+func (d *dnsDNSSECKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config dnssecKeyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Algorithm.IsUnknown() || config.Algorithm.IsNull() || config.Bits.IsNull() {
+		return
+	}
+
+	if config.Algorithm.ValueString() != dns.AlgorithmToString[dns.RSASHA256] {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("bits"),
+			"Invalid Attribute Combination",
+			"bits can only be set for RSASHA256 keys, "+config.Algorithm.ValueString()+" keys have a fixed size.",
+		)
+	}
+}
+
+func (d *dnsDNSSECKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dnssecKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alg, err := dnssec.Algorithm(plan.Algorithm.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating DNSSEC key:", err.Error())
+		return
+	}
+
+	bits := dnssec.DefaultBits(alg)
+	if !plan.Bits.IsUnknown() && !plan.Bits.IsNull() {
+		bits = int(plan.Bits.ValueInt64())
+	}
+
+	zone, err := idn.ToASCII(plan.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating DNSSEC key:", err.Error())
+		return
+	}
+
+	key, err := dnssec.GenerateKey(zone, alg, uint16(plan.Flags.ValueInt64()), bits)
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating DNSSEC key:", err.Error())
+		return
+	}
+
+	dsSHA256, err := dnssec.DS(key.DNSKEY, dns.SHA256)
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating DNSSEC key:", err.Error())
+		return
+	}
+	dsSHA384, err := dnssec.DS(key.DNSKEY, dns.SHA384)
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating DNSSEC key:", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(dnssec.FileName(key.DNSKEY))
+	plan.Bits = types.Int64Value(int64(bits))
+	plan.PublicKey = types.StringValue(key.DNSKEY.PublicKey)
+	plan.PrivateKey = types.StringValue(key.PrivateKey)
+	plan.KeyTag = types.Int64Value(int64(key.DNSKEY.KeyTag()))
+	plan.DNSKEY = types.StringValue(rdata.Text(key.DNSKEY))
+	plan.DSSHA256 = types.StringValue(rdata.Text(dsSHA256))
+	plan.DSSHA384 = types.StringValue(rdata.Text(dsSHA384))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the state as it is, the key does not exist anywhere else.
+func (d *dnsDNSSECKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update is never called with changes, as every argument requires the key to
+// be replaced.
+func (d *dnsDNSSECKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dnssecKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the key from the state.
+func (d *dnsDNSSECKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+type dnssecKeyResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Zone       types.String `tfsdk:"zone"`
+	Algorithm  types.String `tfsdk:"algorithm"`
+	Bits       types.Int64  `tfsdk:"bits"`
+	Flags      types.Int64  `tfsdk:"flags"`
+	PublicKey  types.String `tfsdk:"public_key"`
+	PrivateKey types.String `tfsdk:"private_key"`
+	KeyTag     types.Int64  `tfsdk:"key_tag"`
+	DNSKEY     types.String `tfsdk:"dnskey"`
+	DSSHA256   types.String `tfsdk:"ds_sha256"`
+	DSSHA384   types.String `tfsdk:"ds_sha384"`
+}
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccDnsDNSSECKey_Basic(t *testing.T) {
+	resourceName := "dns_dnssec_key.foo"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsDNSSECKey_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "flags", "257"),
+					resource.TestCheckResourceAttr(resourceName, "bits", "256"),
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^Kexample\.com\.\+013\+\d{5}$`)),
+					resource.TestMatchResourceAttr(resourceName, "dnskey", regexp.MustCompile(`^257 3 13 \S+$`)),
+					resource.TestMatchResourceAttr(resourceName, "ds_sha256", regexp.MustCompile(`^\d+ 13 2 [0-9a-f]{64}$`)),
+					resource.TestMatchResourceAttr(resourceName, "ds_sha384", regexp.MustCompile(`^\d+ 13 4 [0-9a-f]{96}$`)),
+					resource.TestMatchResourceAttr(resourceName, "private_key", regexp.MustCompile(`^Private-key-format: v1.3\n`)),
+				),
+			},
+			{
+				Config: testAccDnsDNSSECKey_basic,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccDnsDNSSECKey_rsa,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "flags", "256"),
+					resource.TestCheckResourceAttr(resourceName, "bits", "1024"),
+					resource.TestMatchResourceAttr(resourceName, "dnskey", regexp.MustCompile(`^256 3 8 \S+$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDnsDNSSECKey_InvalidBits(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDnsDNSSECKey_invalidBits,
+				ExpectError: regexp.MustCompile("bits can only be set for RSASHA256 keys"),
+			},
+		},
+	})
+}
+
+var testAccDnsDNSSECKey_basic = `
+  resource "dns_dnssec_key" "foo" {
+    zone = "example.com."
+    algorithm = "ECDSAP256SHA256"
+  }`
+
+var testAccDnsDNSSECKey_rsa = `
+  resource "dns_dnssec_key" "foo" {
+    zone = "example.com."
+    algorithm = "RSASHA256"
+    bits = 1024
+    flags = 256
+  }`
+
+var testAccDnsDNSSECKey_invalidBits = `
+  resource "dns_dnssec_key" "foo" {
+    zone = "example.com."
+    algorithm = "ED25519"
+    bits = 2048
+  }`
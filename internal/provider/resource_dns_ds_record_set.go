@@ -0,0 +1,453 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/dnstypes"
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
+	"github.com/hashicorp/terraform-provider-dns/internal/validators/dnsvalidator"
+)
+
+var (
+	_ resource.Resource                = (*dnsDSRecordSetResource)(nil)
+	_ resource.ResourceWithImportState = (*dnsDSRecordSetResource)(nil)
+	_ resource.ResourceWithIdentity    = (*dnsDSRecordSetResource)(nil)
+	_ resource.ResourceWithConfigure   = (*dnsDSRecordSetResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*dnsDSRecordSetResource)(nil)
+)
+
+func NewDnsDSRecordSetResource() resource.Resource {
+	return &dnsDSRecordSetResource{}
+}
+
+type dnsDSRecordSetResource struct {
+	client *DNSClient
+}
+
+func (d *dnsDSRecordSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ds_record_set"
+}
+
+func (d *dnsDSRecordSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a DS type DNS record set, which delegates trust to the DNSSEC keys of a child zone " +
+			"from its parent zone.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					dnsvalidator.IsZoneNameValid(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("fqdn")),
+				},
+				Description: "DNS zone the record set belongs to, which is the parent of the delegated zone. It must " +
+					"be an FQDN, that is, include the trailing dot. Exactly one of `zone` or `fqdn` must be set.",
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					dnsvalidator.IsRecordNameValid(),
+					stringvalidator.ConflictsWith(path.MatchRoot("fqdn")),
+				},
+				Description: "The name of the record set, which is the name of the delegated zone. The `zone` " +
+					"argument will be appended to this value to create the full record path.",
+			},
+			"fqdn": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					dnsvalidator.IsRecordFQDNValid(),
+				},
+				Description: "The fully qualified domain name of the record set, as an alternative to `zone` and `name`. " +
+					"The enclosing zone is discovered by looking up SOA records and `zone` and `name` are computed " +
+					"from it. It must include the trailing dot. As the discovered zone is the delegated zone if the " +
+					"server is also authoritative for it, use `zone` and `name` in that case.",
+			},
+			"ttl": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(3600),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "The TTL of the record set. Defaults to `3600`.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Always set to the fully qualified domain name of the record set",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"ds": dsRecordSetBlock("DS"),
+		},
+	}
+}
+
+func (d *dnsDSRecordSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*DNSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DNSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *dnsDSRecordSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resourceDnsModifyPlan_framework(ctx, d.client, dns.TypeDS, req, resp)
+}
+
+func (d *dnsDSRecordSetResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = recordIdentitySchema()
+}
+
+func (d *dnsDSRecordSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dsRecordSetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, configDiags := resourceDnsConfig_framework(plan.Zone, plan.Name, plan.FQDN, d.client)
+	resp.Diagnostics.Append(configDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeDS))...)
+
+	fqdn := resourceFQDN_framework(config)
+	plan.ID = types.StringValue(fqdn)
+	plan.Zone = types.StringValue(config.Zone)
+	plan.Name = config.nameValue()
+	plan.FQDN = types.StringValue(fqdn)
+
+	msg := new(dns.Msg)
+	msg.SetUpdate(config.Zone)
+
+	records, diags := dsRecordSetRecords(ctx, fqdn, dns.TypeDS, plan.TTL.ValueInt64(), plan.DS)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	msg.Insert(records)
+
+	r, err := exchange(msg, true, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating DNS record:", err.Error())
+		return
+	}
+	if r.Rcode != dns.RcodeSuccess {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error updating DNS record: %v", r.Rcode), dns.RcodeToString[r.Rcode])
+		return
+	}
+
+	answers, diags := resourceDnsRead_framework(config, d.client, dns.TypeDS)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if len(answers) > 0 {
+		var ttl int64
+		plan.DS, ttl, diags = dsRecordSetValue(ctx, plan.DS.ElementType(ctx), answers)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		plan.TTL = types.Int64Value(ttl)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	}
+}
+
+func (d *dnsDSRecordSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dsRecordSetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := dnsConfig{
+		Name: state.Name.ValueString(),
+		Zone: state.Zone.ValueString(),
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeDS))...)
+
+	state.FQDN = types.StringValue(resourceFQDN_framework(config))
+
+	answers, diags := resourceDnsRead_framework(config, d.client, dns.TypeDS)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if len(answers) > 0 {
+		var ttl int64
+		state.DS, ttl, diags = dsRecordSetValue(ctx, state.DS.ElementType(ctx), answers)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		state.TTL = types.Int64Value(ttl)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	} else {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+func (d *dnsDSRecordSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state dsRecordSetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := dnsConfig{
+		Name: plan.Name.ValueString(),
+		Zone: plan.Zone.ValueString(),
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeDS))...)
+
+	fqdn := resourceFQDN_framework(config)
+	state.FQDN = types.StringValue(fqdn)
+
+	if !plan.DS.Equal(state.DS) {
+		msg := new(dns.Msg)
+		msg.SetUpdate(plan.Zone.ValueString())
+
+		records, diags := dsRecordSetRecords(ctx, fqdn, dns.TypeDS, plan.TTL.ValueInt64(), plan.DS)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Replace the whole record set within a single update
+		msg.RemoveRRset([]dns.RR{rdata.NewEmpty(fqdn, dns.TypeDS)})
+		msg.Insert(records)
+
+		r, err := exchange(msg, true, d.client)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating DNS record:", err.Error())
+			return
+		}
+		if r.Rcode != dns.RcodeSuccess {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error updating DNS record: %v", r.Rcode),
+				dns.RcodeToString[r.Rcode])
+			return
+		}
+	}
+
+	answers, diags := resourceDnsRead_framework(config, d.client, dns.TypeDS)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if len(answers) > 0 {
+		var ttl int64
+		state.DS, ttl, diags = dsRecordSetValue(ctx, state.DS.ElementType(ctx), answers)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		state.TTL = types.Int64Value(ttl)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	}
+}
+
+func (d *dnsDSRecordSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dsRecordSetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := dnsConfig{
+		Name: state.Name.ValueString(),
+		Zone: state.Zone.ValueString(),
+	}
+
+	resp.Diagnostics.Append(resourceDnsDelete_framework(config, d.client, dns.TypeDS)...)
+}
+
+func (d *dnsDSRecordSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, config, diags := resourceDnsImport_framework(ctx, req, dns.TypeDS, d.client)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fqdn"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), config.Zone)...)
+	if config.Name != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), config.Name)...)
+	}
+}
+
+type dsRecordSetResourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Zone types.String `tfsdk:"zone"`
+	Name types.String `tfsdk:"name"`
+	FQDN types.String `tfsdk:"fqdn"`
+	DS   types.Set    `tfsdk:"ds"` //dsBlockConfig
+	TTL  types.Int64  `tfsdk:"ttl"`
+}
+
+type dsBlockConfig struct {
+	KeyTag     types.Int64  `tfsdk:"key_tag"`
+	Algorithm  types.Int64  `tfsdk:"algorithm"`
+	DigestType types.Int64  `tfsdk:"digest_type"`
+	Digest     dnstypes.Hex `tfsdk:"digest"`
+}
+
+// dsRecordSetBlock returns the block holding the records of a DS or CDS record
+// set resource.
+func dsRecordSetBlock(rrType string) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		Description: "Can be specified multiple times for each " + rrType + " record.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"key_tag": schema.Int64Attribute{
+					Required: true,
+					Validators: []validator.Int64{
+						int64validator.Between(0, 65535),
+					},
+					Description: "The key tag of the referenced DNSKEY record.",
+				},
+				"algorithm": schema.Int64Attribute{
+					Required: true,
+					Validators: []validator.Int64{
+						int64validator.Between(0, 255),
+					},
+					Description: "The algorithm number of the referenced DNSKEY record.",
+				},
+				"digest_type": schema.Int64Attribute{
+					Required: true,
+					Validators: []validator.Int64{
+						int64validator.Between(0, 255),
+					},
+					Description: "The algorithm used to construct the digest, such as `2` for SHA-256 or `4` for SHA-384.",
+				},
+				"digest": schema.StringAttribute{
+					CustomType:  dnstypes.HexType{},
+					Required:    true,
+					Description: "The digest of the referenced DNSKEY record in hexadecimal. Its case is not significant.",
+				},
+			},
+			Validators: []validator.Object{
+				dnsvalidator.IsDigestValid(),
+			},
+		},
+	}
+}
+
+// dsRecordSetRecords returns the records of rrType for the dsBlockConfig
+// elements of set.
+func dsRecordSetRecords(ctx context.Context, fqdn string, rrType uint16, ttl int64, set types.Set) ([]dns.RR, diag.Diagnostics) {
+	var blocks []dsBlockConfig
+
+	diags := set.ElementsAs(ctx, &blocks, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	records := make([]dns.RR, 0, len(blocks))
+	for _, ds := range blocks {
+		record, err := rdata.NewDS(fqdn, rrType, ttl, ds.KeyTag.ValueInt64(), ds.Algorithm.ValueInt64(),
+			ds.DigestType.ValueInt64(), ds.Digest.ValueString())
+		if err != nil {
+			diags.AddError("Error building DNS record:", err.Error())
+			return nil, diags
+		}
+
+		records = append(records, record)
+	}
+
+	return records, diags
+}
+
+// dsRecordSetValue returns the set of dsBlockConfig elements for the DS or CDS
+// records in answers, along with the lowest TTL of the records.
+func dsRecordSetValue(ctx context.Context, elemType attr.Type, answers []dns.RR) (types.Set, int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var ttl sort.IntSlice
+
+	var blocks []dsBlockConfig
+	for _, record := range answers {
+		keyTag, algorithm, digestType, digest, t, err := rdata.DS(record)
+		if err != nil {
+			diags.AddError("Error querying DNS record:", err.Error())
+			return types.SetNull(elemType), 0, diags
+		}
+		blocks = append(blocks, dsBlockConfig{
+			KeyTag:     types.Int64Value(keyTag),
+			Algorithm:  types.Int64Value(algorithm),
+			DigestType: types.Int64Value(digestType),
+			Digest:     dnstypes.NewHexValue(digest),
+		})
+		ttl = append(ttl, t)
+	}
+	sort.Sort(ttl)
+
+	set, convertDiags := types.SetValueFrom(ctx, elemType, blocks)
+	diags.Append(convertDiags...)
+
+	return set, int64(ttl[0]), diags
+}
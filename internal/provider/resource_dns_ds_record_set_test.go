@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/miekg/dns"
+)
+
+func TestAccDnsDSRecordSet_Basic(t *testing.T) {
+	resourceName := "dns_ds_record_set.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDnsDSRecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsDSRecordSet_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ds.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ds.*", map[string]string{
+						"key_tag":     "60485",
+						"algorithm":   "13",
+						"digest_type": "2",
+						"digest":      "e06d44b80b8f1d39a95c0b0d7c65d08458e880409bbc683457104237c7f8ec8d",
+					}),
+				),
+			},
+			{
+				Config: testAccDnsDSRecordSet_basic,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccDnsDSRecordSet_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ds.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ds.*", map[string]string{
+						"key_tag":     "60486",
+						"digest_type": "4",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDnsDSRecordSet_InvalidDigest(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDnsDSRecordSet_invalidDigest,
+				ExpectError: regexp.MustCompile("must be 64 hexadecimal digits long for digest type 2"),
+			},
+		},
+	})
+}
+
+func testAccCheckDnsDSRecordSetDestroy(s *terraform.State) error {
+	return testAccCheckDnsDestroy(s, "dns_ds_record_set", dns.TypeDS)
+}
+
+var testAccDnsDSRecordSet_delegation = `
+  resource "dns_ns_record_set" "child" {
+    zone = "example.com."
+    name = "child"
+    nameservers = ["ns1.example.com."]
+    ttl = 300
+  }`
+
+var testAccDnsDSRecordSet_basic = testAccDnsDSRecordSet_delegation + `
+
+  resource "dns_ds_record_set" "foo" {
+    zone = "example.com."
+    name = dns_ns_record_set.child.name
+    ds {
+      key_tag = 60485
+      algorithm = 13
+      digest_type = 2
+      digest = "E06D44B80B8F1D39A95C0B0D7C65D08458E880409BBC683457104237C7F8EC8D"
+    }
+    ttl = 300
+  }`
+
+var testAccDnsDSRecordSet_update = testAccDnsDSRecordSet_delegation + `
+
+  resource "dns_ds_record_set" "foo" {
+    zone = "example.com."
+    name = dns_ns_record_set.child.name
+    ds {
+      key_tag = 60485
+      algorithm = 13
+      digest_type = 2
+      digest = "e06d44b80b8f1d39a95c0b0d7c65d08458e880409bbc683457104237c7f8ec8d"
+    }
+    ds {
+      key_tag = 60486
+      algorithm = 13
+      digest_type = 4
+      digest = "72d7b62976ce06438e9c0bf319013cf801f09ecc84b8d7e9495f27e305c6a9b0563a9b5f4d288405c3008a946df983d6"
+    }
+    ttl = 300
+  }`
+
+var testAccDnsDSRecordSet_invalidDigest = `
+  resource "dns_ds_record_set" "foo" {
+    zone = "example.com."
+    name = "child"
+    ds {
+      key_tag = 60485
+      algorithm = 13
+      digest_type = 2
+      digest = "2bb183af5f22588179a53b0a98631fad1a292118"
+    }
+  }`
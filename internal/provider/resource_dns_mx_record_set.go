@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,13 +21,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/miekg/dns"
 
+	"github.com/hashicorp/terraform-provider-dns/internal/dnstypes"
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
 	"github.com/hashicorp/terraform-provider-dns/internal/validators/dnsvalidator"
 )
 
 var (
 	_ resource.Resource                = (*dnsMXRecordSetResource)(nil)
 	_ resource.ResourceWithImportState = (*dnsMXRecordSetResource)(nil)
+	_ resource.ResourceWithIdentity    = (*dnsMXRecordSetResource)(nil)
 	_ resource.ResourceWithConfigure   = (*dnsMXRecordSetResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*dnsMXRecordSetResource)(nil)
 )
 
 func NewDnsMXRecordSetResource() resource.Resource {
@@ -46,26 +51,47 @@ func (d *dnsMXRecordSetResource) Schema(ctx context.Context, req resource.Schema
 		Description: "Creates an MX type DNS record set.",
 		Attributes: map[string]schema.Attribute{
 			"zone": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
 					dnsvalidator.IsZoneNameValid(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("fqdn")),
 				},
-				Description: "DNS zone the record set belongs to. It must be an FQDN, that is, include the trailing dot.",
+				Description: "DNS zone the record set belongs to. It must be an FQDN, that is, include the trailing dot. " +
+					"Exactly one of `zone` or `fqdn` must be set.",
 			},
 			"name": schema.StringAttribute{
 				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
 					dnsvalidator.IsRecordNameValid(),
+					stringvalidator.ConflictsWith(path.MatchRoot("fqdn")),
 				},
 				Description: "The name of the record set. The `zone` argument will be appended to this value to create " +
 					"the full record path.",
 			},
+			"fqdn": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					dnsvalidator.IsRecordFQDNValid(),
+				},
+				Description: "The fully qualified domain name of the record set, as an alternative to `zone` and `name`. " +
+					"The enclosing zone is discovered by looking up SOA records and `zone` and `name` are computed " +
+					"from it. It must include the trailing dot.",
+			},
 			"ttl": schema.Int64Attribute{
 				Optional: true,
 				Computed: true,
@@ -90,11 +116,12 @@ func (d *dnsMXRecordSetResource) Schema(ctx context.Context, req resource.Schema
 							Description: "The preference for the record.",
 						},
 						"exchange": schema.StringAttribute{
-							Required: true,
+							CustomType: dnstypes.HostnameType{},
+							Required:   true,
 							Validators: []validator.String{
-								dnsvalidator.IsZoneNameValid(),
+								dnsvalidator.IsHostnameValid(),
 							},
-							Description: "The FQDN of the mail exchange, include the trailing dot.",
+							Description: "The FQDN of the mail exchange. The trailing dot may be omitted.",
 						},
 					},
 				},
@@ -122,6 +149,14 @@ func (d *dnsMXRecordSetResource) Configure(ctx context.Context, req resource.Con
 	d.client = client
 }
 
+func (d *dnsMXRecordSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resourceDnsModifyPlan_framework(ctx, d.client, dns.TypeMX, req, resp)
+}
+
+func (d *dnsMXRecordSetResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = recordIdentitySchema()
+}
+
 func (d *dnsMXRecordSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan mxRecordSetResourceModel
 
@@ -130,15 +165,22 @@ func (d *dnsMXRecordSetResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	config := dnsConfig{
-		Name: plan.Name.ValueString(),
-		Zone: plan.Zone.ValueString(),
+	config, configDiags := resourceDnsConfig_framework(plan.Zone, plan.Name, plan.FQDN, d.client)
+	resp.Diagnostics.Append(configDiags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeMX))...)
+
 	fqdn := resourceFQDN_framework(config)
 	plan.ID = types.StringValue(fqdn)
+	plan.Zone = types.StringValue(config.Zone)
+	plan.Name = config.nameValue()
+	plan.FQDN = types.StringValue(fqdn)
 
 	msg := new(dns.Msg)
-	msg.SetUpdate(plan.Zone.ValueString())
+	msg.SetUpdate(config.Zone)
 
 	var planMX []mxBlockConfig
 	var diags diag.Diagnostics
@@ -150,11 +192,9 @@ func (d *dnsMXRecordSetResource) Create(ctx context.Context, req resource.Create
 
 	// Loop through all the new addresses and insert them
 	for _, mx := range planMX {
-		rrStr := fmt.Sprintf("%s %d MX %d %s", fqdn, plan.TTL.ValueInt64(), mx.Preference.ValueInt64(), mx.Exchange.ValueString())
-
-		rr_insert, err := dns.NewRR(rrStr)
+		rr_insert, err := rdata.NewMX(fqdn, plan.TTL.ValueInt64(), mx.Preference.ValueInt64(), mx.Exchange.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Error reading DNS record (%s):", rrStr), err.Error())
+			resp.Diagnostics.AddError("Error building DNS record:", err.Error())
 			return
 		}
 
@@ -182,18 +222,17 @@ func (d *dnsMXRecordSetResource) Create(ctx context.Context, req resource.Create
 
 		var mx []mxBlockConfig
 		for _, record := range answers {
-			switch r := record.(type) {
-			case *dns.MX:
-				m := mxBlockConfig{
-					Preference: types.Int64Value(int64(r.Preference)),
-					Exchange:   types.StringValue(r.Mx),
-				}
-				mx = append(mx, m)
-				ttl = append(ttl, int(r.Hdr.Ttl))
-			default:
-				resp.Diagnostics.AddError("Error querying DNS record:", "didn't get an MX record")
+			preference, exchange, t, err := rdata.MX(record)
+			if err != nil {
+				resp.Diagnostics.AddError("Error querying DNS record:", err.Error())
 				return
 			}
+			m := mxBlockConfig{
+				Preference: types.Int64Value(preference),
+				Exchange:   dnstypes.NewHostnameValue(exchange),
+			}
+			mx = append(mx, m)
+			ttl = append(ttl, t)
 		}
 		sort.Sort(ttl)
 
@@ -223,6 +262,10 @@ func (d *dnsMXRecordSetResource) Read(ctx context.Context, req resource.ReadRequ
 		Zone: state.Zone.ValueString(),
 	}
 
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeMX))...)
+
+	state.FQDN = types.StringValue(resourceFQDN_framework(config))
+
 	answers, diags := resourceDnsRead_framework(config, d.client, dns.TypeMX)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
@@ -234,18 +277,17 @@ func (d *dnsMXRecordSetResource) Read(ctx context.Context, req resource.ReadRequ
 
 		var mx []mxBlockConfig
 		for _, record := range answers {
-			switch r := record.(type) {
-			case *dns.MX:
-				m := mxBlockConfig{
-					Preference: types.Int64Value(int64(r.Preference)),
-					Exchange:   types.StringValue(r.Mx),
-				}
-				mx = append(mx, m)
-				ttl = append(ttl, int(r.Hdr.Ttl))
-			default:
-				resp.Diagnostics.AddError("Error querying DNS record:", "didn't get an MX record")
+			preference, exchange, t, err := rdata.MX(record)
+			if err != nil {
+				resp.Diagnostics.AddError("Error querying DNS record:", err.Error())
 				return
 			}
+			m := mxBlockConfig{
+				Preference: types.Int64Value(preference),
+				Exchange:   dnstypes.NewHostnameValue(exchange),
+			}
+			mx = append(mx, m)
+			ttl = append(ttl, t)
 		}
 		sort.Sort(ttl)
 
@@ -281,7 +323,11 @@ func (d *dnsMXRecordSetResource) Update(ctx context.Context, req resource.Update
 		Name: plan.Name.ValueString(),
 		Zone: plan.Zone.ValueString(),
 	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeMX))...)
+
 	fqdn := resourceFQDN_framework(config)
+	state.FQDN = types.StringValue(fqdn)
 
 	msg := new(dns.Msg)
 	msg.SetUpdate(plan.Zone.ValueString())
@@ -322,11 +368,9 @@ func (d *dnsMXRecordSetResource) Update(ctx context.Context, req resource.Update
 
 		// Loop through all the old addresses and remove them
 		for _, mx := range remove {
-			rrStr := fmt.Sprintf("%s %d MX %d %s", fqdn, plan.TTL.ValueInt64(), mx.Preference.ValueInt64(), mx.Exchange.ValueString())
-
-			rr_remove, err := dns.NewRR(rrStr)
+			rr_remove, err := rdata.NewMX(fqdn, plan.TTL.ValueInt64(), mx.Preference.ValueInt64(), mx.Exchange.ValueString())
 			if err != nil {
-				resp.Diagnostics.AddError(fmt.Sprintf("Error reading DNS record (%s):", rrStr), err.Error())
+				resp.Diagnostics.AddError("Error building DNS record:", err.Error())
 				return
 			}
 
@@ -334,11 +378,9 @@ func (d *dnsMXRecordSetResource) Update(ctx context.Context, req resource.Update
 		}
 		// Loop through all the new addresses and insert them
 		for _, mx := range add {
-			rrStr := fmt.Sprintf("%s %d MX %d %s", fqdn, plan.TTL.ValueInt64(), mx.Preference.ValueInt64(), mx.Exchange.ValueString())
-
-			rr_insert, err := dns.NewRR(rrStr)
+			rr_insert, err := rdata.NewMX(fqdn, plan.TTL.ValueInt64(), mx.Preference.ValueInt64(), mx.Exchange.ValueString())
 			if err != nil {
-				resp.Diagnostics.AddError(fmt.Sprintf("Error reading DNS record (%s):", rrStr), err.Error())
+				resp.Diagnostics.AddError("Error building DNS record:", err.Error())
 				return
 			}
 
@@ -368,19 +410,17 @@ func (d *dnsMXRecordSetResource) Update(ctx context.Context, req resource.Update
 
 		var mx []mxBlockConfig
 		for _, record := range answers {
-			switch r := record.(type) {
-			case *dns.MX:
-				m := mxBlockConfig{
-					Preference: types.Int64Value(int64(r.Preference)),
-					Exchange:   types.StringValue(r.Mx),
-				}
-				mx = append(mx, m)
-				ttl = append(ttl, int(r.Hdr.Ttl))
-			default:
-				resp.Diagnostics.AddError("Error querying DNS record:",
-					"didn't get an MX record")
+			preference, exchange, t, err := rdata.MX(record)
+			if err != nil {
+				resp.Diagnostics.AddError("Error querying DNS record:", err.Error())
 				return
 			}
+			m := mxBlockConfig{
+				Preference: types.Int64Value(preference),
+				Exchange:   dnstypes.NewHostnameValue(exchange),
+			}
+			mx = append(mx, m)
+			ttl = append(ttl, t)
 		}
 		sort.Sort(ttl)
 
@@ -414,14 +454,14 @@ func (d *dnsMXRecordSetResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (d *dnsMXRecordSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-
-	config, diags := resourceDnsImport_framework(req.ID, d.client)
+	id, config, diags := resourceDnsImport_framework(ctx, req, dns.TypeMX, d.client)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fqdn"), id)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), config.Zone)...)
 	if config.Name != "" {
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), config.Name)...)
@@ -432,6 +472,7 @@ type mxRecordSetResourceModel struct {
 	ID   types.String `tfsdk:"id"`
 	Zone types.String `tfsdk:"zone"`
 	Name types.String `tfsdk:"name"`
+	FQDN types.String `tfsdk:"fqdn"`
 	MX   types.Set    `tfsdk:"mx"` //mxBlockConfig
 	TTL  types.Int64  `tfsdk:"ttl"`
 }
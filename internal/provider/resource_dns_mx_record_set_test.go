@@ -131,6 +131,33 @@ func TestAccDnsMXRecordSet_Basic_Upgrade(t *testing.T) {
 	})
 }
 
+func TestAccDnsMXRecordSet_Hostname(t *testing.T) {
+	resourceName := "dns_mx_record_set.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDnsMXRecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsMXRecordSet_hostname,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mx.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "mx.*", map[string]string{"preference": "10", "exchange": "SMTP.example.org"}),
+				),
+			},
+			{
+				Config: testAccDnsMXRecordSet_hostname,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckDnsMXRecordSetDestroy(s *terraform.State) error {
 	return testAccCheckDnsDestroy(s, "dns_mx_record_set", dns.TypeMX)
 }
@@ -170,3 +197,14 @@ var testAccDnsMXRecordSet_root = `
     }
     ttl = 300
   }`
+
+var testAccDnsMXRecordSet_hostname = `
+  resource "dns_mx_record_set" "foo" {
+    zone = "example.com."
+    name = "foo"
+    mx {
+      preference = 10
+      exchange = "SMTP.example.org"
+    }
+    ttl = 300
+  }`
@@ -0,0 +1,232 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/validators/dnsvalidator"
+)
+
+var (
+	_ resource.Resource              = (*dnsNotifyResource)(nil)
+	_ resource.ResourceWithConfigure = (*dnsNotifyResource)(nil)
+)
+
+func NewDnsNotifyResource() resource.Resource {
+	return &dnsNotifyResource{}
+}
+
+type dnsNotifyResource struct {
+	client *DNSClient
+}
+
+func (d *dnsNotifyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notify"
+}
+
+func (d *dnsNotifyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sends DNS NOTIFY messages, as defined in RFC 1996, to the secondary nameservers of a zone so " +
+			"they transfer its changes without waiting for the SOA refresh interval. The messages are sent when " +
+			"the resource is created and whenever `triggers` change, so reference the record resources of the " +
+			"zone in `triggers`. They are signed with the TSIG key of the provider, if any. Servers which do not " +
+			"acknowledge the message are reported as warnings.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					dnsvalidator.IsZoneNameValid(),
+				},
+				Description: "DNS zone to notify the changes of. It must be an FQDN, that is, include the trailing dot.",
+			},
+			"servers": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				Description: "The secondary nameservers to notify, as host names or IP addresses with an optional " +
+					"port, such as `192.0.2.53` or `[2001:db8::53]:5353`. The port defaults to `53`.",
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Arbitrary values which send the NOTIFY messages again when they change, such as the " +
+					"IDs or attributes of the record resources of the zone.",
+			},
+			"serial": schema.Int64Attribute{
+				Computed: true,
+				Description: "The serial of the zone included in the NOTIFY messages, read from the SOA record on " +
+					"the DNS server of the provider. Unset if the SOA record could not be read.",
+			},
+			"responses": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "The response of each server to the NOTIFY message, which is `NOERROR` when the " +
+					"server acknowledged it, another response code, or the error that occurred.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Always set to the zone.",
+			},
+		},
+	}
+}
+
+func (d *dnsNotifyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*DNSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DNSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *dnsNotifyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan notifyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.Zone
+
+	resp.Diagnostics.Append(d.notify(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the state as is, as NOTIFY messages leave nothing to read back.
+func (d *dnsNotifyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+func (d *dnsNotifyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan notifyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.Zone
+
+	resp.Diagnostics.Append(d.notify(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from the state.
+func (d *dnsNotifyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// notify sends a NOTIFY message for the zone to each server, and sets the
+// serial and responses of plan.
+func (d *dnsNotifyResource) notify(ctx context.Context, plan *notifyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	zone := plan.Zone.ValueString()
+
+	var servers []string
+	diags.Append(plan.Servers.ElementsAs(ctx, &servers, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	msg := new(dns.Msg)
+	msg.SetNotify(zone)
+
+	// The SOA record is an optional hint for the secondaries, as described
+	// in RFC 1996 section 3.7
+	plan.Serial = types.Int64Null()
+	soa, soaDiags := soaRead(zone, d.client)
+	if soaDiags.HasError() || soa == nil {
+		diags.AddWarning("Unable to read the SOA record of the zone:",
+			fmt.Sprintf("The NOTIFY messages for %s are sent without the serial of the zone.", zone))
+	} else {
+		msg.Answer = []dns.RR{soa}
+		plan.Serial = types.Int64Value(int64(soa.Serial))
+	}
+
+	responses := make(map[string]string, len(servers))
+	for _, server := range servers {
+		addr := server
+		if _, _, err := net.SplitHostPort(server); err != nil {
+			addr = net.JoinHostPort(server, "53")
+		}
+
+		// The NOTIFY message is signed with the TSIG key of the provider,
+		// GSS-TSIG contexts are only negotiated with the DNS server of
+		// the provider
+		client := *d.client
+		client.srv_addr = addr
+		client.gssClient = nil
+
+		r, err := exchange(msg.Copy(), true, &client)
+		switch {
+		case err != nil:
+			responses[server] = err.Error()
+			diags.AddWarning("NOTIFY not acknowledged:",
+				fmt.Sprintf("Sending the NOTIFY message for %s to %s failed: %s", zone, server, err))
+		case r.Rcode != dns.RcodeSuccess:
+			responses[server] = dns.RcodeToString[r.Rcode]
+			diags.AddWarning("NOTIFY not acknowledged:",
+				fmt.Sprintf("%s answered the NOTIFY message for %s with %s.", server, zone, dns.RcodeToString[r.Rcode]))
+		default:
+			responses[server] = dns.RcodeToString[r.Rcode]
+			tflog.Info(ctx, "NOTIFY message acknowledged", map[string]interface{}{
+				"zone":   zone,
+				"server": server,
+			})
+		}
+	}
+
+	var convertDiags diag.Diagnostics
+	plan.Responses, convertDiags = types.MapValueFrom(ctx, types.StringType, responses)
+	diags.Append(convertDiags...)
+
+	return diags
+}
+
+type notifyResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Zone      types.String `tfsdk:"zone"`
+	Servers   types.List   `tfsdk:"servers"`
+	Triggers  types.Map    `tfsdk:"triggers"`
+	Serial    types.Int64  `tfsdk:"serial"`
+	Responses types.Map    `tfsdk:"responses"`
+}
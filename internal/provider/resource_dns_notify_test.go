@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDnsNotify_Basic(t *testing.T) {
+	resourceName := "dns_notify.example"
+
+	port := os.Getenv("DNS_UPDATE_PORT")
+	if port == "" {
+		port = "53"
+	}
+	server := net.JoinHostPort(os.Getenv("DNS_UPDATE_SERVER"), port)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsNotifyConfig(server, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "example.com."),
+					resource.TestCheckResourceAttrSet(resourceName, "serial"),
+					resource.TestCheckResourceAttr(resourceName, "responses.%", "1"),
+				),
+			},
+			{
+				Config: testAccDnsNotifyConfig(server, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "serial"),
+					resource.TestCheckResourceAttr(resourceName, "responses.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDnsNotifyConfig(server, trigger string) string {
+	return fmt.Sprintf(`
+  resource "dns_notify" "example" {
+    zone = "example.com."
+    servers = [%q]
+    triggers = {
+      change = %q
+    }
+  }`, server, trigger)
+}
@@ -9,6 +9,7 @@ import (
 	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,13 +22,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/miekg/dns"
 
+	"github.com/hashicorp/terraform-provider-dns/internal/dnstypes"
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
 	"github.com/hashicorp/terraform-provider-dns/internal/validators/dnsvalidator"
 )
 
 var (
 	_ resource.Resource                = (*dnsNSRecordSetResource)(nil)
 	_ resource.ResourceWithImportState = (*dnsNSRecordSetResource)(nil)
+	_ resource.ResourceWithIdentity    = (*dnsNSRecordSetResource)(nil)
 	_ resource.ResourceWithConfigure   = (*dnsNSRecordSetResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*dnsNSRecordSetResource)(nil)
 )
 
 func NewDnsNSRecordSetResource() resource.Resource {
@@ -47,26 +52,48 @@ func (d *dnsNSRecordSetResource) Schema(ctx context.Context, req resource.Schema
 		Description: "Creates an NS type DNS record set.",
 		Attributes: map[string]schema.Attribute{
 			"zone": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
 					dnsvalidator.IsZoneNameValid(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("fqdn")),
+					stringvalidator.AlsoRequires(path.MatchRoot("name")),
 				},
-				Description: "DNS zone the record set belongs to. It must be an FQDN, that is, include the trailing dot.",
+				Description: "DNS zone the record set belongs to. It must be an FQDN, that is, include the trailing dot. " +
+					"Exactly one of `zone` or `fqdn` must be set.",
 			},
 			"name": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
 					dnsvalidator.IsRecordNameValid(),
+					stringvalidator.ConflictsWith(path.MatchRoot("fqdn")),
 				},
 				Description: "The name of the record set. The `zone` argument will be appended to this value to create " +
 					"the full record path.",
 			},
+			"fqdn": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					dnsvalidator.IsRecordFQDNValid(),
+				},
+				Description: "The fully qualified domain name of the record set, as an alternative to `zone` and `name`. " +
+					"The enclosing zone is discovered by looking up SOA records and `zone` and `name` are computed " +
+					"from it. It must include the trailing dot.",
+			},
 			"ttl": schema.Int64Attribute{
 				Optional: true,
 				Computed: true,
@@ -78,11 +105,11 @@ func (d *dnsNSRecordSetResource) Schema(ctx context.Context, req resource.Schema
 			},
 			"nameservers": schema.SetAttribute{
 				Required:    true,
-				ElementType: types.StringType,
+				ElementType: dnstypes.HostnameType{},
 				Validators: []validator.Set{
-					setvalidator.ValueStringsAre(dnsvalidator.IsZoneNameValid()),
+					setvalidator.ValueStringsAre(dnsvalidator.IsHostnameValid()),
 				},
-				Description: "The nameservers this record set will point to.",
+				Description: "The nameservers this record set will point to. The trailing dot may be omitted.",
 			},
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -111,6 +138,14 @@ func (d *dnsNSRecordSetResource) Configure(ctx context.Context, req resource.Con
 	d.client = client
 }
 
+func (d *dnsNSRecordSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resourceDnsModifyPlan_framework(ctx, d.client, dns.TypeNS, req, resp)
+}
+
+func (d *dnsNSRecordSetResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = recordIdentitySchema()
+}
+
 func (d *dnsNSRecordSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan nsRecordSetResourceModel
 
@@ -119,15 +154,22 @@ func (d *dnsNSRecordSetResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	config := dnsConfig{
-		Name: plan.Name.ValueString(),
-		Zone: plan.Zone.ValueString(),
+	config, configDiags := resourceDnsConfig_framework(plan.Zone, plan.Name, plan.FQDN, d.client)
+	resp.Diagnostics.Append(configDiags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeNS))...)
+
 	fqdn := resourceFQDN_framework(config)
 	plan.ID = types.StringValue(fqdn)
+	plan.Zone = types.StringValue(config.Zone)
+	plan.Name = config.nameValue()
+	plan.FQDN = types.StringValue(fqdn)
 
 	msg := new(dns.Msg)
-	msg.SetUpdate(plan.Zone.ValueString())
+	msg.SetUpdate(config.Zone)
 
 	var planNS []string
 
@@ -138,13 +180,7 @@ func (d *dnsNSRecordSetResource) Create(ctx context.Context, req resource.Create
 
 	// Loop through all the new nameservers and insert them
 	for _, nameserver := range planNS {
-		rrStr := fmt.Sprintf("%s %d NS %s", fqdn, plan.TTL.ValueInt64(), nameserver)
-
-		rr_insert, err := dns.NewRR(rrStr)
-		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Error reading DNS record (%s):", rrStr), err.Error())
-			return
-		}
+		rr_insert := rdata.NewNS(fqdn, plan.TTL.ValueInt64(), nameserver)
 
 		msg.Insert([]dns.RR{rr_insert})
 	}
@@ -170,7 +206,7 @@ func (d *dnsNSRecordSetResource) Create(ctx context.Context, req resource.Create
 		var nameservers []string
 
 		for _, record := range answers {
-			nameserver, t, err := getNSVal(record)
+			nameserver, t, err := rdata.NS(record)
 			if err != nil {
 				resp.Diagnostics.AddError("Error querying DNS record:", err.Error())
 				return
@@ -206,6 +242,10 @@ func (d *dnsNSRecordSetResource) Read(ctx context.Context, req resource.ReadRequ
 		Zone: state.Zone.ValueString(),
 	}
 
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeNS))...)
+
+	state.FQDN = types.StringValue(resourceFQDN_framework(config))
+
 	answers, diags := resourceDnsRead_framework(config, d.client, dns.TypeNS)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
@@ -217,7 +257,7 @@ func (d *dnsNSRecordSetResource) Read(ctx context.Context, req resource.ReadRequ
 		var nameservers []string
 
 		for _, record := range answers {
-			nameserver, t, err := getNSVal(record)
+			nameserver, t, err := rdata.NS(record)
 			if err != nil {
 				resp.Diagnostics.AddError("Error querying DNS record:", err.Error())
 				return
@@ -259,7 +299,11 @@ func (d *dnsNSRecordSetResource) Update(ctx context.Context, req resource.Update
 		Name: plan.Name.ValueString(),
 		Zone: plan.Zone.ValueString(),
 	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeNS))...)
+
 	fqdn := resourceFQDN_framework(config)
+	state.FQDN = types.StringValue(fqdn)
 
 	msg := new(dns.Msg)
 	msg.SetUpdate(plan.Zone.ValueString())
@@ -300,25 +344,13 @@ func (d *dnsNSRecordSetResource) Update(ctx context.Context, req resource.Update
 
 		// Loop through all the old nameservers and remove them
 		for _, nameserver := range remove {
-			rrStr := fmt.Sprintf("%s %d NS %s", fqdn, plan.TTL.ValueInt64(), nameserver)
-
-			rr_remove, err := dns.NewRR(rrStr)
-			if err != nil {
-				resp.Diagnostics.AddError(fmt.Sprintf("Error reading DNS record (%s):", rrStr), err.Error())
-				return
-			}
+			rr_remove := rdata.NewNS(fqdn, plan.TTL.ValueInt64(), nameserver)
 
 			msg.Remove([]dns.RR{rr_remove})
 		}
 		// Loop through all the new nameservers and insert them
 		for _, nameserver := range add {
-			rrStr := fmt.Sprintf("%s %d NS %s", fqdn, plan.TTL.ValueInt64(), nameserver)
-
-			rr_insert, err := dns.NewRR(rrStr)
-			if err != nil {
-				resp.Diagnostics.AddError(fmt.Sprintf("Error reading DNS record (%s):", rrStr), err.Error())
-				return
-			}
+			rr_insert := rdata.NewNS(fqdn, plan.TTL.ValueInt64(), nameserver)
 
 			msg.Insert([]dns.RR{rr_insert})
 		}
@@ -345,7 +377,7 @@ func (d *dnsNSRecordSetResource) Update(ctx context.Context, req resource.Update
 		var nameservers []string
 
 		for _, record := range answers {
-			nameserver, t, err := getNSVal(record)
+			nameserver, t, err := rdata.NS(record)
 			if err != nil {
 				resp.Diagnostics.AddError("Error querying DNS record:", err.Error())
 				return
@@ -385,14 +417,14 @@ func (d *dnsNSRecordSetResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (d *dnsNSRecordSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-
-	config, diags := resourceDnsImport_framework(req.ID, d.client)
+	id, config, diags := resourceDnsImport_framework(ctx, req, dns.TypeNS, d.client)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fqdn"), id)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), config.Name)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), config.Zone)...)
 }
@@ -401,6 +433,7 @@ type nsRecordSetResourceModel struct {
 	ID          types.String `tfsdk:"id"`
 	Zone        types.String `tfsdk:"zone"`
 	Name        types.String `tfsdk:"name"`
+	FQDN        types.String `tfsdk:"fqdn"`
 	Nameservers types.Set    `tfsdk:"nameservers"`
 	TTL         types.Int64  `tfsdk:"ttl"`
 }
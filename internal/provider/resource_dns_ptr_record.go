@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -17,13 +19,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/miekg/dns"
 
+	"github.com/hashicorp/terraform-provider-dns/internal/dnstypes"
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
 	"github.com/hashicorp/terraform-provider-dns/internal/validators/dnsvalidator"
 )
 
 var (
 	_ resource.Resource                = (*dnsPTRRecordResource)(nil)
 	_ resource.ResourceWithImportState = (*dnsPTRRecordResource)(nil)
+	_ resource.ResourceWithIdentity    = (*dnsPTRRecordResource)(nil)
 	_ resource.ResourceWithConfigure   = (*dnsPTRRecordResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*dnsPTRRecordResource)(nil)
 )
 
 func NewDnsPTRRecordResource() resource.Resource {
@@ -43,32 +49,51 @@ func (d *dnsPTRRecordResource) Schema(ctx context.Context, req resource.SchemaRe
 		Description: "Creates a PTR type DNS record.",
 		Attributes: map[string]schema.Attribute{
 			"zone": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
 					dnsvalidator.IsZoneNameValid(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("fqdn")),
 				},
-				Description: "DNS zone the record set belongs to. It must be an FQDN, that is, include the trailing dot.",
+				Description: "DNS zone the record set belongs to. It must be an FQDN, that is, include the trailing dot. " +
+					"Exactly one of `zone` or `fqdn` must be set.",
 			},
 			"name": schema.StringAttribute{
 				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
 					dnsvalidator.IsRecordNameValid(),
+					stringvalidator.ConflictsWith(path.MatchRoot("fqdn")),
 				},
 				Description: "The name of the record. The `zone` argument will be appended to this value to create " +
 					"the full record path.",
 			},
-			"ptr": schema.StringAttribute{
-				Required: true,
+			"fqdn": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
 				Validators: []validator.String{
-					dnsvalidator.IsZoneNameValid(),
+					dnsvalidator.IsRecordFQDNValid(),
 				},
-				Description: "The canonical name this record will point to.",
+				Description: "The fully qualified domain name of the record, as an alternative to `zone` and `name`. " +
+					"The enclosing zone is discovered by looking up SOA records and `zone` and `name` are computed " +
+					"from it. It must include the trailing dot.",
+			},
+			"ptr": schema.StringAttribute{
+				CustomType:  dnstypes.HostnameType{},
+				Required:    true,
+				Description: "The canonical name this record will point to. The trailing dot may be omitted.",
 			},
 			"ttl": schema.Int64Attribute{
 				Optional: true,
@@ -107,6 +132,14 @@ func (d *dnsPTRRecordResource) Configure(ctx context.Context, req resource.Confi
 	d.client = client
 }
 
+func (d *dnsPTRRecordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resourceDnsModifyPlan_framework(ctx, d.client, dns.TypePTR, req, resp)
+}
+
+func (d *dnsPTRRecordResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = recordIdentitySchema()
+}
+
 func (d *dnsPTRRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan ptrRecordSetResourceModel
 
@@ -115,24 +148,25 @@ func (d *dnsPTRRecordResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	config := dnsConfig{
-		Name: plan.Name.ValueString(),
-		Zone: plan.Zone.ValueString(),
+	config, configDiags := resourceDnsConfig_framework(plan.Zone, plan.Name, plan.FQDN, d.client)
+	resp.Diagnostics.Append(configDiags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypePTR))...)
+
 	rec_fqdn := resourceFQDN_framework(config)
 	plan.ID = types.StringValue(rec_fqdn)
+	plan.Zone = types.StringValue(config.Zone)
+	plan.Name = config.nameValue()
+	plan.FQDN = types.StringValue(rec_fqdn)
 
 	msg := new(dns.Msg)
-	msg.SetUpdate(plan.Zone.ValueString())
+	msg.SetUpdate(config.Zone)
 
 	//Insert new PTR record
-	rrStrInsert := fmt.Sprintf("%s %d PTR %s", rec_fqdn, plan.TTL.ValueInt64(), plan.PTR.ValueString())
-
-	rr_insert, err := dns.NewRR(rrStrInsert)
-	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Error reading DNS record (%s):", rrStrInsert), err.Error())
-		return
-	}
+	rr_insert := rdata.NewPTR(rec_fqdn, plan.TTL.ValueInt64(), plan.PTR.ValueString())
 
 	msg.Insert([]dns.RR{rr_insert})
 
@@ -159,13 +193,13 @@ func (d *dnsPTRRecordResource) Create(ctx context.Context, req resource.CreateRe
 			return
 		}
 		record := answers[0]
-		ptr, ttl, err := getPtrVal(record)
+		ptr, ttl, err := rdata.PTR(record)
 		if err != nil {
 			resp.Diagnostics.AddError("Error querying DNS record:", err.Error())
 			return
 		}
 
-		plan.PTR = types.StringValue(ptr)
+		plan.PTR = dnstypes.NewHostnameValue(ptr)
 		plan.TTL = types.Int64Value(int64(ttl))
 
 		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -185,6 +219,10 @@ func (d *dnsPTRRecordResource) Read(ctx context.Context, req resource.ReadReques
 		Zone: state.Zone.ValueString(),
 	}
 
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypePTR))...)
+
+	state.FQDN = types.StringValue(resourceFQDN_framework(config))
+
 	answers, diags := resourceDnsRead_framework(config, d.client, dns.TypePTR)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
@@ -197,13 +235,13 @@ func (d *dnsPTRRecordResource) Read(ctx context.Context, req resource.ReadReques
 			return
 		}
 		record := answers[0]
-		ptr, ttl, err := getPtrVal(record)
+		ptr, ttl, err := rdata.PTR(record)
 		if err != nil {
 			resp.Diagnostics.AddError("Error querying DNS record:", err.Error())
 			return
 		}
 
-		state.PTR = types.StringValue(ptr)
+		state.PTR = dnstypes.NewHostnameValue(ptr)
 		state.TTL = types.Int64Value(int64(ttl))
 
 		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -229,32 +267,30 @@ func (d *dnsPTRRecordResource) Update(ctx context.Context, req resource.UpdateRe
 		Name: plan.Name.ValueString(),
 		Zone: plan.Zone.ValueString(),
 	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypePTR))...)
+
 	rec_fqdn := resourceFQDN_framework(config)
+	state.FQDN = types.StringValue(rec_fqdn)
 
 	msg := new(dns.Msg)
 	msg.SetUpdate(plan.Zone.ValueString())
 
-	if !plan.PTR.Equal(state.PTR) {
+	equal, equalDiags := plan.PTR.StringSemanticEquals(ctx, state.PTR)
+	resp.Diagnostics.Append(equalDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !equal {
 
 		//Remove old PTR record
-		rrStrRemove := fmt.Sprintf("%s %d PTR %s", rec_fqdn, plan.TTL.ValueInt64(), state.PTR.ValueString())
-
-		rr_remove, err := dns.NewRR(rrStrRemove)
-		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Error reading DNS record (%s):", rrStrRemove), err.Error())
-			return
-		}
+		rr_remove := rdata.NewPTR(rec_fqdn, plan.TTL.ValueInt64(), state.PTR.ValueString())
 
 		msg.Remove([]dns.RR{rr_remove})
 
 		//Insert new PTR record
-		rrStrInsert := fmt.Sprintf("%s %d PTR %s", rec_fqdn, plan.TTL.ValueInt64(), plan.PTR.ValueString())
-
-		rr_insert, err := dns.NewRR(rrStrInsert)
-		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Error reading DNS record (%s):", rrStrInsert), err.Error())
-			return
-		}
+		rr_insert := rdata.NewPTR(rec_fqdn, plan.TTL.ValueInt64(), plan.PTR.ValueString())
 
 		msg.Insert([]dns.RR{rr_insert})
 
@@ -281,13 +317,13 @@ func (d *dnsPTRRecordResource) Update(ctx context.Context, req resource.UpdateRe
 			return
 		}
 		record := answers[0]
-		ptr, ttl, err := getPtrVal(record)
+		ptr, ttl, err := rdata.PTR(record)
 		if err != nil {
 			resp.Diagnostics.AddError("Error querying DNS record:", err.Error())
 			return
 		}
 
-		state.PTR = types.StringValue(ptr)
+		state.PTR = dnstypes.NewHostnameValue(ptr)
 		state.TTL = types.Int64Value(int64(ttl))
 
 		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -313,13 +349,14 @@ func (d *dnsPTRRecordResource) Delete(ctx context.Context, req resource.DeleteRe
 func (d *dnsPTRRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var state ptrRecordSetResourceModel
 
-	config, diags := resourceDnsImport_framework(req.ID, d.client)
+	id, config, diags := resourceDnsImport_framework(ctx, req, dns.TypePTR, d.client)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
 	}
 
-	state.ID = types.StringValue(req.ID)
+	state.ID = types.StringValue(id)
+	state.FQDN = types.StringValue(id)
 	state.Zone = types.StringValue(config.Zone)
 	if config.Name != "" {
 		state.Name = types.StringValue(config.Name)
@@ -329,9 +366,10 @@ func (d *dnsPTRRecordResource) ImportState(ctx context.Context, req resource.Imp
 }
 
 type ptrRecordSetResourceModel struct {
-	ID   types.String `tfsdk:"id"`
-	Zone types.String `tfsdk:"zone"`
-	Name types.String `tfsdk:"name"`
-	PTR  types.String `tfsdk:"ptr"`
-	TTL  types.Int64  `tfsdk:"ttl"`
+	ID   types.String      `tfsdk:"id"`
+	Zone types.String      `tfsdk:"zone"`
+	Name types.String      `tfsdk:"name"`
+	FQDN types.String      `tfsdk:"fqdn"`
+	PTR  dnstypes.Hostname `tfsdk:"ptr"`
+	TTL  types.Int64       `tfsdk:"ttl"`
 }
@@ -0,0 +1,420 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/dnstypes"
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
+	"github.com/hashicorp/terraform-provider-dns/internal/validators/dnsvalidator"
+)
+
+const (
+	soaSerialIncrement = "increment"
+	soaSerialUnixtime  = "unixtime"
+	soaSerialDate      = "date"
+)
+
+var (
+	_ resource.Resource                = (*dnsSOAResource)(nil)
+	_ resource.ResourceWithImportState = (*dnsSOAResource)(nil)
+	_ resource.ResourceWithConfigure   = (*dnsSOAResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*dnsSOAResource)(nil)
+)
+
+func NewDnsSOAResource() resource.Resource {
+	return &dnsSOAResource{}
+}
+
+type dnsSOAResource struct {
+	client *DNSClient
+}
+
+func (d *dnsSOAResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_soa"
+}
+
+func (d *dnsSOAResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	timer := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.Int64{
+				int64validator.Between(0, math.MaxUint32),
+			},
+			Description: description + " Defaults to the current value in the zone.",
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the fields of the SOA record of an existing zone. The SOA record is replaced with a " +
+			"dynamic update, bumping the serial according to `serial_strategy`, whenever a field changes. " +
+			"Destroying the resource leaves the SOA record as is.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					dnsvalidator.IsZoneNameValid(),
+				},
+				Description: "DNS zone of the SOA record. It must be an FQDN, that is, include the trailing dot.",
+			},
+			"mname": schema.StringAttribute{
+				CustomType: dnstypes.HostnameType{},
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The primary nameserver of the zone. The trailing dot may be omitted. Defaults to the " +
+					"current value in the zone.",
+			},
+			"rname": schema.StringAttribute{
+				CustomType: dnstypes.HostnameType{},
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The mailbox of the person responsible for the zone, with the `@` replaced by a dot, " +
+					"such as `hostmaster.example.com.`. The trailing dot may be omitted. Defaults to the current " +
+					"value in the zone.",
+			},
+			"refresh": timer("The interval in seconds before secondary nameservers check the serial for changes."),
+			"retry":   timer("The interval in seconds before secondary nameservers retry a failed refresh."),
+			"expire": timer("The time in seconds after which secondary nameservers stop answering for the zone " +
+				"when refreshes keep failing."),
+			"minimum": timer("The TTL in seconds of negative answers, as defined in RFC 2308."),
+			"ttl":     timer("The TTL of the SOA record."),
+			"serial": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "The serial of the zone, bumped whenever a field of the SOA record changes.",
+			},
+			"serial_strategy": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(soaSerialIncrement),
+				Validators: []validator.String{
+					stringvalidator.OneOf(soaSerialIncrement, soaSerialUnixtime, soaSerialDate),
+				},
+				Description: "How the serial is bumped. `increment` adds one to the serial, `unixtime` sets it to " +
+					"the current Unix time and `date` sets it to the current date in the `YYYYMMDDnn` format. As " +
+					"the serial must always increase, the strategies fall back to adding one to the serial. " +
+					"Defaults to `increment`.",
+			},
+			"serial_bump_trigger": schema.StringAttribute{
+				Optional: true,
+				Description: "An arbitrary value which bumps the serial when it changes, so secondary nameservers " +
+					"transfer the zone after changes made out of band.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Always set to the zone.",
+			},
+		},
+	}
+}
+
+func (d *dnsSOAResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*DNSClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DNSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// ModifyPlan marks the serial as unknown when the SOA record is going to be
+// replaced.
+func (d *dnsSOAResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state soaResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.changes(state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("serial"), types.Int64Unknown())...)
+	}
+}
+
+func (d *dnsSOAResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan soaResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.Zone
+
+	resp.Diagnostics.Append(d.apply(&plan, !plan.SerialBumpTrigger.IsNull())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (d *dnsSOAResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state soaResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	soa, diags := soaRead(state.Zone.ValueString(), d.client)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if soa != nil {
+		state.setSOA(soa)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	} else {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+func (d *dnsSOAResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state soaResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.apply(&plan, !plan.SerialBumpTrigger.Equal(state.SerialBumpTrigger))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from the state, as a zone cannot exist
+// without its SOA record.
+func (d *dnsSOAResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (d *dnsSOAResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial_strategy"), soaSerialIncrement)...)
+}
+
+// apply replaces the SOA record of the zone when the planned fields differ
+// from the current ones, or when bump is set, and reads it back into plan.
+func (d *dnsSOAResource) apply(plan *soaResourceModel, bump bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	zone := plan.Zone.ValueString()
+
+	current, readDiags := soaRead(zone, d.client)
+	diags.Append(readDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	if current == nil {
+		diags.AddError("Error querying DNS record:", fmt.Sprintf("zone %s has no SOA record", zone))
+		return diags
+	}
+
+	value := func(v types.Int64, current uint32) int64 {
+		if v.IsNull() || v.IsUnknown() {
+			return int64(current)
+		}
+		return v.ValueInt64()
+	}
+
+	mname, rname := current.Ns, current.Mbox
+	if !plan.MName.IsNull() && !plan.MName.IsUnknown() {
+		mname = plan.MName.ValueString()
+	}
+	if !plan.RName.IsNull() && !plan.RName.IsUnknown() {
+		rname = plan.RName.ValueString()
+	}
+
+	soa, err := rdata.NewSOA(current.Hdr.Name, value(plan.TTL, current.Hdr.Ttl), mname, rname, int64(current.Serial),
+		value(plan.Refresh, current.Refresh), value(plan.Retry, current.Retry), value(plan.Expire, current.Expire),
+		value(plan.Minimum, current.Minttl))
+	if err != nil {
+		diags.AddError("Error building DNS record:", err.Error())
+		return diags
+	}
+
+	if bump || !dns.IsDuplicate(soa, current) || soa.Hdr.Ttl != current.Hdr.Ttl {
+		soa.Serial = soaNextSerial(current.Serial, plan.SerialStrategy.ValueString(), time.Now())
+
+		// Adding an SOA record replaces the existing one, as described in
+		// RFC 2136 section 3.4.2.2
+		msg := new(dns.Msg)
+		msg.SetUpdate(zone)
+		msg.Insert([]dns.RR{soa})
+
+		r, err := exchange(msg, true, d.client)
+		if err != nil {
+			diags.AddError("Error updating DNS record:", err.Error())
+			return diags
+		}
+		if r.Rcode != dns.RcodeSuccess {
+			diags.AddError(fmt.Sprintf("Error updating DNS record: %v", r.Rcode), dns.RcodeToString[r.Rcode])
+			return diags
+		}
+
+		current, readDiags = soaRead(zone, d.client)
+		diags.Append(readDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		if current == nil {
+			diags.AddError("Error querying DNS record:", fmt.Sprintf("zone %s has no SOA record", zone))
+			return diags
+		}
+	}
+
+	plan.setSOA(current)
+
+	return diags
+}
+
+// soaRead returns the SOA record of zone, or nil if there is none.
+func soaRead(zone string, client *DNSClient) (*dns.SOA, diag.Diagnostics) {
+	answers, diags := resourceDnsRead_framework(dnsConfig{Zone: zone}, client, dns.TypeSOA)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, record := range answers {
+		if soa, ok := record.(*dns.SOA); ok {
+			return soa, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// soaNextSerial returns the serial following current with strategy. Serials
+// are compared with the sequence space arithmetic of RFC 1982, so the next
+// serial is always greater even when it wraps around.
+func soaNextSerial(current uint32, strategy string, now time.Time) uint32 {
+	var next uint32
+
+	switch strategy {
+	case soaSerialUnixtime:
+		next = uint32(now.Unix())
+	case soaSerialDate:
+		date, _ := strconv.ParseUint(now.UTC().Format("20060102"), 10, 32)
+		next = uint32(date) * 100
+	}
+
+	if next != current && next-current < 1<<31 {
+		return next
+	}
+
+	return current + 1
+}
+
+type soaResourceModel struct {
+	ID                types.String      `tfsdk:"id"`
+	Zone              types.String      `tfsdk:"zone"`
+	MName             dnstypes.Hostname `tfsdk:"mname"`
+	RName             dnstypes.Hostname `tfsdk:"rname"`
+	Serial            types.Int64       `tfsdk:"serial"`
+	Refresh           types.Int64       `tfsdk:"refresh"`
+	Retry             types.Int64       `tfsdk:"retry"`
+	Expire            types.Int64       `tfsdk:"expire"`
+	Minimum           types.Int64       `tfsdk:"minimum"`
+	TTL               types.Int64       `tfsdk:"ttl"`
+	SerialStrategy    types.String      `tfsdk:"serial_strategy"`
+	SerialBumpTrigger types.String      `tfsdk:"serial_bump_trigger"`
+}
+
+// changes reports whether applying m over state replaces the SOA record.
+func (m soaResourceModel) changes(state soaResourceModel) bool {
+	changed := func(planned, current types.Int64) bool {
+		return !planned.IsUnknown() && !planned.Equal(current)
+	}
+
+	mnameEqual, _ := m.MName.StringSemanticEquals(context.Background(), state.MName)
+	rnameEqual, _ := m.RName.StringSemanticEquals(context.Background(), state.RName)
+
+	return !m.SerialBumpTrigger.Equal(state.SerialBumpTrigger) ||
+		!m.MName.IsUnknown() && !mnameEqual ||
+		!m.RName.IsUnknown() && !rnameEqual ||
+		changed(m.Refresh, state.Refresh) ||
+		changed(m.Retry, state.Retry) ||
+		changed(m.Expire, state.Expire) ||
+		changed(m.Minimum, state.Minimum) ||
+		changed(m.TTL, state.TTL)
+}
+
+// setSOA sets the fields of m from soa. Names equivalent to the current ones
+// keep their spelling.
+func (m *soaResourceModel) setSOA(soa *dns.SOA) {
+	if equal, _ := m.MName.StringSemanticEquals(context.Background(), dnstypes.NewHostnameValue(soa.Ns)); !equal {
+		m.MName = dnstypes.NewHostnameValue(soa.Ns)
+	}
+	if equal, _ := m.RName.StringSemanticEquals(context.Background(), dnstypes.NewHostnameValue(soa.Mbox)); !equal {
+		m.RName = dnstypes.NewHostnameValue(soa.Mbox)
+	}
+
+	m.Serial = types.Int64Value(int64(soa.Serial))
+	m.Refresh = types.Int64Value(int64(soa.Refresh))
+	m.Retry = types.Int64Value(int64(soa.Retry))
+	m.Expire = types.Int64Value(int64(soa.Expire))
+	m.Minimum = types.Int64Value(int64(soa.Minttl))
+	m.TTL = types.Int64Value(int64(soa.Hdr.Ttl))
+}
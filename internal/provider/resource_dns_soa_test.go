@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestSoaNextSerial(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		current  uint32
+		strategy string
+		expected uint32
+	}{
+		"increment": {
+			current:  41,
+			strategy: soaSerialIncrement,
+			expected: 42,
+		},
+		"increment-wrap": {
+			current:  1<<32 - 1,
+			strategy: soaSerialIncrement,
+			expected: 0,
+		},
+		"unixtime": {
+			current:  1,
+			strategy: soaSerialUnixtime,
+			expected: uint32(now.Unix()),
+		},
+		"unixtime-behind": {
+			current:  uint32(now.Unix()) + 10,
+			strategy: soaSerialUnixtime,
+			expected: uint32(now.Unix()) + 11,
+		},
+		"date": {
+			current:  2026101703,
+			strategy: soaSerialDate,
+			expected: 2026101800,
+		},
+		"date-same-day": {
+			current:  2026101800,
+			strategy: soaSerialDate,
+			expected: 2026101801,
+		},
+		"date-from-unixtime": {
+			current:  uint32(now.Unix()),
+			strategy: soaSerialDate,
+			expected: 2026101800,
+		},
+		"date-ahead": {
+			current:  2026101905,
+			strategy: soaSerialDate,
+			expected: 2026101906,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if serial := soaNextSerial(testCase.current, testCase.strategy, now); serial != testCase.expected {
+				t.Errorf("expected serial %d, got %d", testCase.expected, serial)
+			}
+		})
+	}
+}
+
+func TestAccDnsSOA_Basic(t *testing.T) {
+	resourceName := "dns_soa.example"
+	var serial int64
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsSOA_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "minimum", "300"),
+					resource.TestCheckResourceAttr(resourceName, "refresh", "7200"),
+					resource.TestCheckResourceAttrSet(resourceName, "mname"),
+					testAccCheckDnsSOASerial(resourceName, &serial),
+				),
+			},
+			{
+				Config: testAccDnsSOA_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "minimum", "600"),
+					testAccCheckDnsSOASerial(resourceName, &serial),
+				),
+			},
+			{
+				Config: testAccDnsSOA_trigger,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "minimum", "600"),
+					testAccCheckDnsSOASerial(resourceName, &serial),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           "example.com.",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"serial_bump_trigger"},
+			},
+		},
+	})
+}
+
+// testAccCheckDnsSOASerial checks that the serial increased since the last
+// step.
+func testAccCheckDnsSOASerial(name string, serial *int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		current, err := strconv.ParseInt(rs.Primary.Attributes["serial"], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		if current <= *serial {
+			return fmt.Errorf("expected serial greater than %d, got %d", *serial, current)
+		}
+		*serial = current
+
+		return nil
+	}
+}
+
+var testAccDnsSOA_basic = `
+  resource "dns_soa" "example" {
+    zone = "example.com."
+    refresh = 7200
+    minimum = 300
+  }`
+
+var testAccDnsSOA_update = `
+  resource "dns_soa" "example" {
+    zone = "example.com."
+    refresh = 7200
+    minimum = 600
+    serial_strategy = "date"
+  }`
+
+var testAccDnsSOA_trigger = `
+  resource "dns_soa" "example" {
+    zone = "example.com."
+    refresh = 7200
+    minimum = 600
+    serial_strategy = "date"
+    serial_bump_trigger = "1"
+  }`
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,13 +21,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/miekg/dns"
 
+	"github.com/hashicorp/terraform-provider-dns/internal/dnstypes"
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
 	"github.com/hashicorp/terraform-provider-dns/internal/validators/dnsvalidator"
 )
 
 var (
 	_ resource.Resource                = (*dnsSRVRecordSetResource)(nil)
 	_ resource.ResourceWithImportState = (*dnsSRVRecordSetResource)(nil)
+	_ resource.ResourceWithIdentity    = (*dnsSRVRecordSetResource)(nil)
 	_ resource.ResourceWithConfigure   = (*dnsSRVRecordSetResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*dnsSRVRecordSetResource)(nil)
 )
 
 func NewDnsSRVRecordSetResource() resource.Resource {
@@ -46,26 +51,48 @@ func (d *dnsSRVRecordSetResource) Schema(ctx context.Context, req resource.Schem
 		Description: "Creates an SRV type DNS record set.",
 		Attributes: map[string]schema.Attribute{
 			"zone": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
 					dnsvalidator.IsZoneNameValid(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("fqdn")),
+					stringvalidator.AlsoRequires(path.MatchRoot("name")),
 				},
-				Description: "DNS zone the record set belongs to. It must be an FQDN, that is, include the trailing dot.",
+				Description: "DNS zone the record set belongs to. It must be an FQDN, that is, include the trailing dot. " +
+					"Exactly one of `zone` or `fqdn` must be set.",
 			},
 			"name": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
 					dnsvalidator.IsRecordNameValid(),
+					stringvalidator.ConflictsWith(path.MatchRoot("fqdn")),
 				},
 				Description: "The name of the record set. The `zone` argument will be appended to this value to create " +
 					"the full record path.",
 			},
+			"fqdn": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					dnsvalidator.IsRecordFQDNValid(),
+				},
+				Description: "The fully qualified domain name of the record set, as an alternative to `zone` and `name`. " +
+					"The enclosing zone is discovered by looking up SOA records and `zone` and `name` are computed " +
+					"from it. It must include the trailing dot.",
+			},
 			"ttl": schema.Int64Attribute{
 				Optional: true,
 				Computed: true,
@@ -98,11 +125,12 @@ func (d *dnsSRVRecordSetResource) Schema(ctx context.Context, req resource.Schem
 							Description: "The port for the service on the target.",
 						},
 						"target": schema.StringAttribute{
-							Required: true,
+							CustomType: dnstypes.HostnameType{},
+							Required:   true,
 							Validators: []validator.String{
-								dnsvalidator.IsZoneNameValid(),
+								dnsvalidator.IsHostnameValid(),
 							},
-							Description: "The FQDN of the target, include the trailing dot.",
+							Description: "The FQDN of the target. The trailing dot may be omitted.",
 						},
 					},
 				},
@@ -130,6 +158,14 @@ func (d *dnsSRVRecordSetResource) Configure(ctx context.Context, req resource.Co
 	d.client = client
 }
 
+func (d *dnsSRVRecordSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resourceDnsModifyPlan_framework(ctx, d.client, dns.TypeSRV, req, resp)
+}
+
+func (d *dnsSRVRecordSetResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = recordIdentitySchema()
+}
+
 func (d *dnsSRVRecordSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan srvRecordSetResourceModel
 
@@ -138,15 +174,22 @@ func (d *dnsSRVRecordSetResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	config := dnsConfig{
-		Name: plan.Name.ValueString(),
-		Zone: plan.Zone.ValueString(),
+	config, configDiags := resourceDnsConfig_framework(plan.Zone, plan.Name, plan.FQDN, d.client)
+	resp.Diagnostics.Append(configDiags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeSRV))...)
+
 	fqdn := resourceFQDN_framework(config)
 	plan.ID = types.StringValue(fqdn)
+	plan.Zone = types.StringValue(config.Zone)
+	plan.Name = config.nameValue()
+	plan.FQDN = types.StringValue(fqdn)
 
 	msg := new(dns.Msg)
-	msg.SetUpdate(plan.Zone.ValueString())
+	msg.SetUpdate(config.Zone)
 
 	var planSRV []srvBlockConfig
 
@@ -157,12 +200,10 @@ func (d *dnsSRVRecordSetResource) Create(ctx context.Context, req resource.Creat
 
 	// Loop through all the new addresses and insert them
 	for _, srv := range planSRV {
-		rrStr := fmt.Sprintf("%s %d SRV %d %d %d %s", fqdn, plan.TTL.ValueInt64(), srv.Priority.ValueInt64(),
-			srv.Weight.ValueInt64(), srv.Port.ValueInt64(), srv.Target.ValueString())
-
-		rr_insert, err := dns.NewRR(rrStr)
+		rr_insert, err := rdata.NewSRV(fqdn, plan.TTL.ValueInt64(), srv.Priority.ValueInt64(), srv.Weight.ValueInt64(),
+			srv.Port.ValueInt64(), srv.Target.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Error reading DNS record (%s):", rrStr), err.Error())
+			resp.Diagnostics.AddError("Error building DNS record:", err.Error())
 			return
 		}
 
@@ -190,21 +231,19 @@ func (d *dnsSRVRecordSetResource) Create(ctx context.Context, req resource.Creat
 		var srv []srvBlockConfig
 
 		for _, record := range answers {
-			switch r := record.(type) {
-			case *dns.SRV:
-				s := srvBlockConfig{
-					Priority: types.Int64Value(int64(r.Priority)),
-					Weight:   types.Int64Value(int64(r.Weight)),
-					Port:     types.Int64Value(int64(r.Port)),
-					Target:   types.StringValue(r.Target),
-				}
-				srv = append(srv, s)
-				ttl = append(ttl, int(r.Hdr.Ttl))
-			default:
-				resp.Diagnostics.AddError("Error querying DNS record:",
-					"didn't get an SRV record")
+			priority, weight, port, target, t, err := rdata.SRV(record)
+			if err != nil {
+				resp.Diagnostics.AddError("Error querying DNS record:", err.Error())
 				return
 			}
+			s := srvBlockConfig{
+				Priority: types.Int64Value(priority),
+				Weight:   types.Int64Value(weight),
+				Port:     types.Int64Value(port),
+				Target:   dnstypes.NewHostnameValue(target),
+			}
+			srv = append(srv, s)
+			ttl = append(ttl, t)
 		}
 		sort.Sort(ttl)
 
@@ -234,6 +273,10 @@ func (d *dnsSRVRecordSetResource) Read(ctx context.Context, req resource.ReadReq
 		Zone: state.Zone.ValueString(),
 	}
 
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeSRV))...)
+
+	state.FQDN = types.StringValue(resourceFQDN_framework(config))
+
 	answers, diags := resourceDnsRead_framework(config, d.client, dns.TypeSRV)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
@@ -245,21 +288,19 @@ func (d *dnsSRVRecordSetResource) Read(ctx context.Context, req resource.ReadReq
 		var srv []srvBlockConfig
 
 		for _, record := range answers {
-			switch r := record.(type) {
-			case *dns.SRV:
-				s := srvBlockConfig{
-					Priority: types.Int64Value(int64(r.Priority)),
-					Weight:   types.Int64Value(int64(r.Weight)),
-					Port:     types.Int64Value(int64(r.Port)),
-					Target:   types.StringValue(r.Target),
-				}
-				srv = append(srv, s)
-				ttl = append(ttl, int(r.Hdr.Ttl))
-			default:
-				resp.Diagnostics.AddError("Error querying DNS record:",
-					"didn't get an SRV record")
+			priority, weight, port, target, t, err := rdata.SRV(record)
+			if err != nil {
+				resp.Diagnostics.AddError("Error querying DNS record:", err.Error())
 				return
 			}
+			s := srvBlockConfig{
+				Priority: types.Int64Value(priority),
+				Weight:   types.Int64Value(weight),
+				Port:     types.Int64Value(port),
+				Target:   dnstypes.NewHostnameValue(target),
+			}
+			srv = append(srv, s)
+			ttl = append(ttl, t)
 		}
 		sort.Sort(ttl)
 
@@ -295,7 +336,11 @@ func (d *dnsSRVRecordSetResource) Update(ctx context.Context, req resource.Updat
 		Name: plan.Name.ValueString(),
 		Zone: plan.Zone.ValueString(),
 	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeSRV))...)
+
 	fqdn := resourceFQDN_framework(config)
+	state.FQDN = types.StringValue(fqdn)
 
 	msg := new(dns.Msg)
 	msg.SetUpdate(plan.Zone.ValueString())
@@ -336,12 +381,10 @@ func (d *dnsSRVRecordSetResource) Update(ctx context.Context, req resource.Updat
 
 		// Loop through all the old addresses and remove them
 		for _, srv := range remove {
-			rrStr := fmt.Sprintf("%s %d SRV %d %d %d %s", fqdn, plan.TTL.ValueInt64(), srv.Priority.ValueInt64(),
-				srv.Weight.ValueInt64(), srv.Port.ValueInt64(), srv.Target.ValueString())
-
-			rr_remove, err := dns.NewRR(rrStr)
+			rr_remove, err := rdata.NewSRV(fqdn, plan.TTL.ValueInt64(), srv.Priority.ValueInt64(), srv.Weight.ValueInt64(),
+				srv.Port.ValueInt64(), srv.Target.ValueString())
 			if err != nil {
-				resp.Diagnostics.AddError(fmt.Sprintf("Error reading DNS record (%s):", rrStr), err.Error())
+				resp.Diagnostics.AddError("Error building DNS record:", err.Error())
 				return
 			}
 
@@ -349,12 +392,10 @@ func (d *dnsSRVRecordSetResource) Update(ctx context.Context, req resource.Updat
 		}
 		// Loop through all the new addresses and insert them
 		for _, srv := range add {
-			rrStr := fmt.Sprintf("%s %d SRV %d %d %d %s", fqdn, plan.TTL.ValueInt64(), srv.Priority.ValueInt64(),
-				srv.Weight.ValueInt64(), srv.Port.ValueInt64(), srv.Target.ValueString())
-
-			rr_insert, err := dns.NewRR(rrStr)
+			rr_insert, err := rdata.NewSRV(fqdn, plan.TTL.ValueInt64(), srv.Priority.ValueInt64(), srv.Weight.ValueInt64(),
+				srv.Port.ValueInt64(), srv.Target.ValueString())
 			if err != nil {
-				resp.Diagnostics.AddError(fmt.Sprintf("Error reading DNS record (%s):", rrStr), err.Error())
+				resp.Diagnostics.AddError("Error building DNS record:", err.Error())
 				return
 			}
 
@@ -383,21 +424,19 @@ func (d *dnsSRVRecordSetResource) Update(ctx context.Context, req resource.Updat
 		var srv []srvBlockConfig
 
 		for _, record := range answers {
-			switch r := record.(type) {
-			case *dns.SRV:
-				s := srvBlockConfig{
-					Priority: types.Int64Value(int64(r.Priority)),
-					Weight:   types.Int64Value(int64(r.Weight)),
-					Port:     types.Int64Value(int64(r.Port)),
-					Target:   types.StringValue(r.Target),
-				}
-				srv = append(srv, s)
-				ttl = append(ttl, int(r.Hdr.Ttl))
-			default:
-				resp.Diagnostics.AddError("Error querying DNS record:",
-					"didn't get an SRV record")
+			priority, weight, port, target, t, err := rdata.SRV(record)
+			if err != nil {
+				resp.Diagnostics.AddError("Error querying DNS record:", err.Error())
 				return
 			}
+			s := srvBlockConfig{
+				Priority: types.Int64Value(priority),
+				Weight:   types.Int64Value(weight),
+				Port:     types.Int64Value(port),
+				Target:   dnstypes.NewHostnameValue(target),
+			}
+			srv = append(srv, s)
+			ttl = append(ttl, t)
 		}
 		sort.Sort(ttl)
 
@@ -430,14 +469,14 @@ func (d *dnsSRVRecordSetResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (d *dnsSRVRecordSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-
-	config, diags := resourceDnsImport_framework(req.ID, d.client)
+	id, config, diags := resourceDnsImport_framework(ctx, req, dns.TypeSRV, d.client)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fqdn"), id)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), config.Name)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), config.Zone)...)
 }
@@ -446,6 +485,7 @@ type srvRecordSetResourceModel struct {
 	ID   types.String `tfsdk:"id"`
 	Zone types.String `tfsdk:"zone"`
 	Name types.String `tfsdk:"name"`
+	FQDN types.String `tfsdk:"fqdn"`
 	SRV  types.Set    `tfsdk:"srv"` //srvBlockConfig
 	TTL  types.Int64  `tfsdk:"ttl"`
 }
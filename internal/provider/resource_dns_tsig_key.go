@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/miekg/dns"
+)
+
+var _ resource.Resource = (*dnsTSIGKeyResource)(nil)
+
+// tsigKeyAlgorithms are the HMAC algorithms supported by convertHMACAlgorithm.
+var tsigKeyAlgorithms = []string{"hmac-md5", "hmac-sha1", "hmac-sha256", "hmac-sha512"}
+
+func NewDnsTSIGKeyResource() resource.Resource {
+	return &dnsTSIGKeyResource{}
+}
+
+type dnsTSIGKeyResource struct{}
+
+func (d *dnsTSIGKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tsig_key"
+}
+
+func (d *dnsTSIGKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a TSIG key to sign DNS messages with, as defined in RFC 8945. The secret is " +
+			"generated locally and stored in the Terraform state, no DNS server is contacted. Use the " +
+			"`dns_tsig_key` ephemeral resource to keep the secret out of the state.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The name of the key, such as `tsig-key.`.",
+			},
+			"algorithm": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("hmac-sha256"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(tsigKeyAlgorithms...),
+				},
+				Description: "The HMAC algorithm of the key. Valid values are `hmac-md5`, `hmac-sha1`, " +
+					"`hmac-sha256` or `hmac-sha512`. Defaults to `hmac-sha256`.",
+			},
+			"secret": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The Base64-encoded secret of the key, as long as the output of the HMAC algorithm.",
+			},
+			"bind_config": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The `key` statement declaring the key in the BIND configuration.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Always set to the name of the key.",
+			},
+		},
+	}
+}
+
+func (d *dnsTSIGKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan tsigKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, bindConfig, err := generateTSIGKey(plan.Name.ValueString(), plan.Algorithm.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating TSIG key:", err.Error())
+		return
+	}
+
+	plan.ID = plan.Name
+	plan.Secret = types.StringValue(secret)
+	plan.BindConfig = types.StringValue(bindConfig)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the state as it is, the key does not exist anywhere else.
+func (d *dnsTSIGKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update is never called with changes, as every argument requires the key to
+// be replaced.
+func (d *dnsTSIGKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan tsigKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the key from the state.
+func (d *dnsTSIGKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// generateTSIGKey returns a random Base64-encoded secret for a key of the
+// given HMAC algorithm, as long as the output of its hash function as
+// recommended by RFC 8945 section 6, and the BIND key statement for it.
+func generateTSIGKey(name, algorithm string) (string, string, error) {
+	if _, ok := dns.IsDomainName(name); !ok {
+		return "", "", fmt.Errorf("Invalid key name: %q", name)
+	}
+
+	alg, err := convertHMACAlgorithm(algorithm)
+	if err != nil {
+		return "", "", err
+	}
+
+	var size int
+	switch alg {
+	case dns.HmacMD5:
+		size = 16
+	case dns.HmacSHA1:
+		size = 20
+	case dns.HmacSHA256:
+		size = 32
+	case dns.HmacSHA512:
+		size = 64
+	}
+
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	secret := base64.StdEncoding.EncodeToString(b)
+
+	bindConfig := fmt.Sprintf("key %q {\n\talgorithm %s;\n\tsecret %q;\n};\n", name, algorithm, secret)
+
+	return secret, bindConfig, nil
+}
+
+type tsigKeyResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Algorithm  types.String `tfsdk:"algorithm"`
+	Secret     types.String `tfsdk:"secret"`
+	BindConfig types.String `tfsdk:"bind_config"`
+}
@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/base64"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestGenerateTSIGKey(t *testing.T) {
+	for algorithm, size := range map[string]int{
+		"hmac-md5":    16,
+		"hmac-sha1":   20,
+		"hmac-sha256": 32,
+		"hmac-sha512": 64,
+	} {
+		t.Run(algorithm, func(t *testing.T) {
+			secret, bindConfig, err := generateTSIGKey("tsig-key.", algorithm)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			b, err := base64.StdEncoding.DecodeString(secret)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(b) != size {
+				t.Errorf("expected a secret of %d bytes, got %d", size, len(b))
+			}
+
+			expected := "key \"tsig-key.\" {\n\talgorithm " + algorithm + ";\n\tsecret \"" + secret + "\";\n};\n"
+			if bindConfig != expected {
+				t.Errorf("expected BIND configuration %q, got %q", expected, bindConfig)
+			}
+		})
+	}
+
+	if _, _, err := generateTSIGKey("tsig-key.", "hmac-sha384"); err == nil || !strings.Contains(err.Error(), "Unknown HMAC algorithm") {
+		t.Errorf("expected unknown algorithm error, got %v", err)
+	}
+	if _, _, err := generateTSIGKey("tsig..key.", "hmac-sha256"); err == nil {
+		t.Error("expected invalid name error, got none")
+	}
+}
+
+func TestAccDnsTSIGKey_Basic(t *testing.T) {
+	resourceName := "dns_tsig_key.foo"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsTSIGKey_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "tsig-key."),
+					resource.TestCheckResourceAttr(resourceName, "algorithm", "hmac-sha256"),
+					resource.TestMatchResourceAttr(resourceName, "secret", regexp.MustCompile(`^[A-Za-z0-9+/]{43}=$`)),
+					resource.TestMatchResourceAttr(resourceName, "bind_config", regexp.MustCompile(`^key "tsig-key\." \{\n\talgorithm hmac-sha256;\n`)),
+				),
+			},
+			{
+				Config: testAccDnsTSIGKey_basic,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccDnsTSIGKey_sha512,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "algorithm", "hmac-sha512"),
+					resource.TestMatchResourceAttr(resourceName, "secret", regexp.MustCompile(`^[A-Za-z0-9+/]{86}==$`)),
+				),
+			},
+		},
+	})
+}
+
+var testAccDnsTSIGKey_basic = `
+  resource "dns_tsig_key" "foo" {
+    name = "tsig-key."
+  }`
+
+var testAccDnsTSIGKey_sha512 = `
+  resource "dns_tsig_key" "foo" {
+    name = "tsig-key."
+    algorithm = "hmac-sha512"
+  }`
@@ -9,6 +9,9 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,13 +24,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/miekg/dns"
 
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
 	"github.com/hashicorp/terraform-provider-dns/internal/validators/dnsvalidator"
 )
 
 var (
 	_ resource.Resource                = (*dnsTXTRecordSetResource)(nil)
 	_ resource.ResourceWithImportState = (*dnsTXTRecordSetResource)(nil)
+	_ resource.ResourceWithIdentity    = (*dnsTXTRecordSetResource)(nil)
 	_ resource.ResourceWithConfigure   = (*dnsTXTRecordSetResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*dnsTXTRecordSetResource)(nil)
 )
 
 func NewDnsTXTRecordSetResource() resource.Resource {
@@ -47,30 +53,69 @@ func (d *dnsTXTRecordSetResource) Schema(ctx context.Context, req resource.Schem
 		Description: "Creates a TXT type DNS record set.",
 		Attributes: map[string]schema.Attribute{
 			"zone": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
 					dnsvalidator.IsZoneNameValid(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("fqdn")),
 				},
-				Description: "DNS zone the record set belongs to. It must be an FQDN, that is, include the trailing dot.",
+				Description: "DNS zone the record set belongs to. It must be an FQDN, that is, include the trailing dot. " +
+					"Exactly one of `zone` or `fqdn` must be set.",
 			},
 			"name": schema.StringAttribute{
 				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
 					dnsvalidator.IsRecordNameValid(),
+					stringvalidator.ConflictsWith(path.MatchRoot("fqdn")),
 				},
 				Description: "The name of the record set. The `zone` argument will be appended to this value to create " +
 					"the full record path.",
 			},
+			"fqdn": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					dnsvalidator.IsRecordFQDNValid(),
+				},
+				Description: "The fully qualified domain name of the record set, as an alternative to `zone` and `name`. " +
+					"The enclosing zone is discovered by looking up SOA records and `zone` and `name` are computed " +
+					"from it. It must include the trailing dot.",
+			},
 			"txt": schema.SetAttribute{
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
-				Description: "The text records this record set will be set to.",
+				Validators: []validator.Set{
+					setvalidator.ExactlyOneOf(path.MatchRoot("chunks")),
+				},
+				Description: "The text records this record set will be set to. Values longer than 255 bytes are split " +
+					"into multiple character-strings automatically. Exactly one of `txt` or `chunks` must be set.",
+			},
+			"chunks": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+				Validators: []validator.Set{
+					setvalidator.ValueListsAre(
+						listvalidator.SizeAtLeast(1),
+						listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(rdata.MaxTXTStringLength)),
+					),
+				},
+				Description: "The text records this record set will be set to, each given as the exact list of " +
+					"character-strings of at most 255 bytes it is made of.",
 			},
 			"ttl": schema.Int64Attribute{
 				Optional: true,
@@ -108,6 +153,14 @@ func (d *dnsTXTRecordSetResource) Configure(ctx context.Context, req resource.Co
 	d.client = client
 }
 
+func (d *dnsTXTRecordSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resourceDnsModifyPlan_framework(ctx, d.client, dns.TypeTXT, req, resp)
+}
+
+func (d *dnsTXTRecordSetResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = recordIdentitySchema()
+}
+
 func (d *dnsTXTRecordSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan txtRecordSetResourceModel
 
@@ -116,34 +169,32 @@ func (d *dnsTXTRecordSetResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	config := dnsConfig{
-		Name: plan.Name.ValueString(),
-		Zone: plan.Zone.ValueString(),
+	config, configDiags := resourceDnsConfig_framework(plan.Zone, plan.Name, plan.FQDN, d.client)
+	resp.Diagnostics.Append(configDiags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeTXT))...)
+
 	fqdn := resourceFQDN_framework(config)
 	plan.ID = types.StringValue(fqdn)
+	plan.Zone = types.StringValue(config.Zone)
+	plan.Name = config.nameValue()
+	plan.FQDN = types.StringValue(fqdn)
 
 	msg := new(dns.Msg)
-	msg.SetUpdate(plan.Zone.ValueString())
-
-	var planTXT []string
+	msg.SetUpdate(config.Zone)
 
-	resp.Diagnostics.Append(plan.TXT.ElementsAs(ctx, &planTXT, false)...)
+	records, diags := plan.records(ctx)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Loop through all the new addresses and insert them
-	for _, txt := range planTXT {
-		rrStr := fmt.Sprintf("%s %d TXT \"%s\"", fqdn, plan.TTL.ValueInt64(), txt)
-
-		rr_insert, err := dns.NewRR(rrStr)
-		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Error reading DNS record (%s):", rrStr), err.Error())
-			return
-		}
-
-		msg.Insert([]dns.RR{rr_insert})
+	// Loop through all the new records and insert them
+	for _, chunks := range records {
+		msg.Insert([]dns.RR{rdata.NewTXT(fqdn, plan.TTL.ValueInt64(), chunks)})
 	}
 
 	r, err := exchange(msg, true, d.client)
@@ -163,31 +214,11 @@ func (d *dnsTXTRecordSetResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	if len(answers) > 0 {
-		var ttl sort.IntSlice
-		var txt []string
-
-		for _, record := range answers {
-			switch r := record.(type) {
-			case *dns.TXT:
-				txt = append(txt, strings.Join(r.Txt, ""))
-				ttl = append(ttl, int(r.Hdr.Ttl))
-			default:
-				resp.Diagnostics.AddError("Error querying DNS record:",
-					"didn't get an TXT record")
-				return
-			}
-		}
-		sort.Sort(ttl)
-
-		var convertDiags diag.Diagnostics
-		plan.TXT, convertDiags = types.SetValueFrom(ctx, plan.TXT.ElementType(ctx), txt)
-		if convertDiags.HasError() {
-			resp.Diagnostics.Append(convertDiags...)
+		resp.Diagnostics.Append(plan.setRecords(ctx, answers)...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
 
-		plan.TTL = types.Int64Value(int64(ttl[0]))
-
 		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	}
 }
@@ -205,6 +236,10 @@ func (d *dnsTXTRecordSetResource) Read(ctx context.Context, req resource.ReadReq
 		Zone: state.Zone.ValueString(),
 	}
 
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeTXT))...)
+
+	state.FQDN = types.StringValue(resourceFQDN_framework(config))
+
 	answers, diags := resourceDnsRead_framework(config, d.client, dns.TypeTXT)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
@@ -212,31 +247,11 @@ func (d *dnsTXTRecordSetResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	if len(answers) > 0 {
-		var ttl sort.IntSlice
-		var txt []string
-
-		for _, record := range answers {
-			switch r := record.(type) {
-			case *dns.TXT:
-				txt = append(txt, strings.Join(r.Txt, ""))
-				ttl = append(ttl, int(r.Hdr.Ttl))
-			default:
-				resp.Diagnostics.AddError("Error querying DNS record:",
-					"didn't get an TXT record")
-				return
-			}
-		}
-		sort.Sort(ttl)
-
-		var convertDiags diag.Diagnostics
-		state.TXT, convertDiags = types.SetValueFrom(ctx, state.TXT.ElementType(ctx), txt)
-		if convertDiags.HasError() {
-			resp.Diagnostics.Append(convertDiags...)
+		resp.Diagnostics.Append(state.setRecords(ctx, answers)...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
 
-		state.TTL = types.Int64Value(int64(ttl[0]))
-
 		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	} else {
 		resp.State.RemoveResource(ctx)
@@ -260,68 +275,51 @@ func (d *dnsTXTRecordSetResource) Update(ctx context.Context, req resource.Updat
 		Name: plan.Name.ValueString(),
 		Zone: plan.Zone.ValueString(),
 	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, recordIdentity(config, dns.TypeTXT))...)
+
 	fqdn := resourceFQDN_framework(config)
+	state.FQDN = types.StringValue(fqdn)
 
 	msg := new(dns.Msg)
 	msg.SetUpdate(plan.Zone.ValueString())
 
-	if !plan.TXT.Equal(state.TXT) {
-
-		var planTXT, stateTXT []string
-
-		resp.Diagnostics.Append(plan.TXT.ElementsAs(ctx, &planTXT, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		resp.Diagnostics.Append(state.TXT.ElementsAs(ctx, &stateTXT, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		var add []string
-		for _, newTXT := range planTXT {
-			for _, oldTXT := range stateTXT {
-				if oldTXT == newTXT {
-					continue
-				}
-			}
-			add = append(add, newTXT)
-		}
-
-		var remove []string
-		for _, oldTXT := range stateTXT {
-			for _, newTXT := range planTXT {
-				if oldTXT == newTXT {
-					continue
-				}
-			}
-			remove = append(remove, oldTXT)
-		}
+	planRecords, diags := plan.records(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		// Loop through all the old addresses and remove them
-		for _, txt := range remove {
-			rrStr := fmt.Sprintf("%s %d TXT \"%s\"", fqdn, plan.TTL.ValueInt64(), txt)
+	stateRecords, diags := state.records(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-			rr_remove, err := dns.NewRR(rrStr)
-			if err != nil {
-				resp.Diagnostics.AddError(fmt.Sprintf("Error reading DNS record (%s):", rrStr), err.Error())
-				return
-			}
+	add := make(map[string]dns.RR)
+	for _, chunks := range planRecords {
+		rr := rdata.NewTXT(fqdn, plan.TTL.ValueInt64(), chunks)
+		add[rr.String()] = rr
+	}
 
-			msg.Remove([]dns.RR{rr_remove})
+	// The old records are built from the state, with the TTL they were written
+	// with. RFC 2136 deletes of individual records only match their rdata, so
+	// the TTL does not prevent their removal
+	var remove []dns.RR
+	for _, chunks := range stateRecords {
+		rr := rdata.NewTXT(fqdn, state.TTL.ValueInt64(), chunks)
+		if _, ok := add[rr.String()]; ok {
+			delete(add, rr.String())
+			continue
 		}
-		// Loop through all the new addresses and insert them
-		for _, txt := range add {
-			rrStr := fmt.Sprintf("%s %d TXT \"%s\"", fqdn, plan.TTL.ValueInt64(), txt)
-
-			rr_insert, err := dns.NewRR(rrStr)
-			if err != nil {
-				resp.Diagnostics.AddError(fmt.Sprintf("Error reading DNS record (%s):", rrStr), err.Error())
-				return
-			}
+		remove = append(remove, rr)
+	}
 
-			msg.Insert([]dns.RR{rr_insert})
+	if len(add) > 0 || len(remove) > 0 {
+		// Remove all the old records and insert the new ones
+		msg.Remove(remove)
+		for _, rr := range add {
+			msg.Insert([]dns.RR{rr})
 		}
 
 		r, err := exchange(msg, true, d.client)
@@ -342,31 +340,11 @@ func (d *dnsTXTRecordSetResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	if len(answers) > 0 {
-		var ttl sort.IntSlice
-		var txt []string
-
-		for _, record := range answers {
-			switch r := record.(type) {
-			case *dns.TXT:
-				txt = append(txt, strings.Join(r.Txt, ""))
-				ttl = append(ttl, int(r.Hdr.Ttl))
-			default:
-				resp.Diagnostics.AddError("Error querying DNS record: ",
-					"didn't get an TXT record")
-				return
-			}
-		}
-		sort.Sort(ttl)
-
-		var convertDiags diag.Diagnostics
-		state.TXT, convertDiags = types.SetValueFrom(ctx, state.TXT.ElementType(ctx), txt)
-		if convertDiags.HasError() {
-			resp.Diagnostics.Append(convertDiags...)
+		resp.Diagnostics.Append(state.setRecords(ctx, answers)...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
 
-		state.TTL = types.Int64Value(int64(ttl[0]))
-
 		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	}
 }
@@ -388,14 +366,14 @@ func (d *dnsTXTRecordSetResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (d *dnsTXTRecordSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-
-	config, diags := resourceDnsImport_framework(req.ID, d.client)
+	id, config, diags := resourceDnsImport_framework(ctx, req, dns.TypeTXT, d.client)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fqdn"), id)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), config.Zone)...)
 	if config.Name != "" {
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), config.Name)...)
@@ -403,9 +381,62 @@ func (d *dnsTXTRecordSetResource) ImportState(ctx context.Context, req resource.
 }
 
 type txtRecordSetResourceModel struct {
-	ID   types.String `tfsdk:"id"`
-	Zone types.String `tfsdk:"zone"`
-	Name types.String `tfsdk:"name"`
-	TXT  types.Set    `tfsdk:"txt"`
-	TTL  types.Int64  `tfsdk:"ttl"`
+	ID     types.String `tfsdk:"id"`
+	Zone   types.String `tfsdk:"zone"`
+	Name   types.String `tfsdk:"name"`
+	FQDN   types.String `tfsdk:"fqdn"`
+	TXT    types.Set    `tfsdk:"txt"`
+	Chunks types.Set    `tfsdk:"chunks"`
+	TTL    types.Int64  `tfsdk:"ttl"`
+}
+
+// records returns the character-strings of each record in the set. The
+// explicit chunks are used when known, otherwise every txt value is split
+// into character-strings of at most 255 bytes.
+func (m txtRecordSetResourceModel) records(ctx context.Context) ([][]string, diag.Diagnostics) {
+	var records [][]string
+	var diags diag.Diagnostics
+
+	if !m.Chunks.IsNull() && !m.Chunks.IsUnknown() {
+		diags.Append(m.Chunks.ElementsAs(ctx, &records, false)...)
+		return records, diags
+	}
+
+	var txt []string
+	diags.Append(m.TXT.ElementsAs(ctx, &txt, false)...)
+	for _, value := range txt {
+		records = append(records, rdata.SplitTXT(value))
+	}
+
+	return records, diags
+}
+
+// setRecords sets txt, chunks and ttl from the TXT records in answers.
+func (m *txtRecordSetResourceModel) setRecords(ctx context.Context, answers []dns.RR) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var ttl sort.IntSlice
+	var txt []string
+	var records [][]string
+
+	for _, record := range answers {
+		chunks, t, err := rdata.TXT(record)
+		if err != nil {
+			diags.AddError("Error querying DNS record:", err.Error())
+			return diags
+		}
+		txt = append(txt, strings.Join(chunks, ""))
+		records = append(records, chunks)
+		ttl = append(ttl, t)
+	}
+	sort.Sort(ttl)
+
+	var convertDiags diag.Diagnostics
+	m.TXT, convertDiags = types.SetValueFrom(ctx, types.StringType, txt)
+	diags.Append(convertDiags...)
+	m.Chunks, convertDiags = types.SetValueFrom(ctx, types.ListType{ElemType: types.StringType}, records)
+	diags.Append(convertDiags...)
+
+	m.TTL = types.Int64Value(int64(ttl[0]))
+
+	return diags
 }
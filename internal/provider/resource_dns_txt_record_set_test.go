@@ -4,6 +4,8 @@
 package provider
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -135,6 +137,57 @@ func TestAccDnsTXTRecordSet_Basic_Upgrade(t *testing.T) {
 	})
 }
 
+func TestAccDnsTXTRecordSet_Chunks(t *testing.T) {
+	resourceName := "dns_txt_record_set.foo"
+	long := strings.Repeat("a", 255) + strings.Repeat("b", 45)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDnsARecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDnsTXTRecordSet_long, long),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "txt.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "txt.*", long),
+					resource.TestCheckTypeSetElemAttr(resourceName, "txt.*", `say "hi" \ bye`),
+					resource.TestCheckResourceAttr(resourceName, "chunks.#", "2"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDnsTXTRecordSet_long, long),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(testAccDnsTXTRecordSet_chunks, strings.Repeat("a", 255), strings.Repeat("b", 45)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: fmt.Sprintf(testAccDnsTXTRecordSet_chunks, "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "txt.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "txt.*", "foobar"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "txt.*", `say "hi" \ bye`),
+				),
+			},
+		},
+	})
+}
+
 var testAccDnsTXTRecordSet_basic = `
   resource "dns_txt_record_set" "foo" {
     zone = "example.com."
@@ -157,3 +210,19 @@ var testAccDnsTXTRecordSet_root = `
     txt = ["foo"]
     ttl = 300
   }`
+
+var testAccDnsTXTRecordSet_long = `
+  resource "dns_txt_record_set" "foo" {
+    zone = "example.com."
+    name = "foo"
+    txt = ["%s", "say \"hi\" \\ bye"]
+    ttl = 300
+  }`
+
+var testAccDnsTXTRecordSet_chunks = `
+  resource "dns_txt_record_set" "foo" {
+    zone = "example.com."
+    name = "foo"
+    chunks = [["%s", "%s"], ["say \"hi\" \\ bye"]]
+    ttl = 300
+  }`
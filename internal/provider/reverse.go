@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/dnstypes"
+	"github.com/hashicorp/terraform-provider-dns/internal/rdata"
+)
+
+// ptrChanges returns the addresses whose PTR records must be added and
+// removed when the addresses of a record set change from current to planned,
+// and the management of its PTR records from currentManaged to
+// plannedManaged.
+func ptrChanges(planned, current []dnstypes.IPAddress, plannedManaged, currentManaged bool) ([]string, []string) {
+	switch {
+	case plannedManaged && currentManaged:
+		return addressDifference(planned, current)
+	case plannedManaged:
+		add, _ := addressDifference(planned, nil)
+		return add, nil
+	case currentManaged:
+		_, remove := addressDifference(nil, current)
+		return nil, remove
+	}
+
+	return nil, nil
+}
+
+// ptrUpdate adds and removes the PTR records pointing the reverse names of
+// the given addresses to fqdn. The reverse zone of each address is discovered
+// by looking up SOA records, so IPv4 and IPv6 reverse zones delegated on any
+// octet or nibble boundary are supported, and one UPDATE message is sent to
+// each of them. Removing a PTR record leaves any other PTR record of the
+// reverse name in place.
+func ptrUpdate(fqdn string, ttl int64, add, remove []string, client *DNSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	msgs := make(map[string]*dns.Msg)
+	message := func(addr string) (*dns.Msg, *dns.PTR) {
+		name, err := dns.ReverseAddr(addr)
+		if err != nil {
+			diags.AddError("Error building PTR record:", err.Error())
+			return nil, nil
+		}
+
+		zone, _, err := resourceDnsDiscover(name, client)
+		if err != nil {
+			diags.AddError("Error discovering reverse DNS zone:",
+				fmt.Sprintf("The reverse zone of %s could not be discovered: %s", addr, err))
+			return nil, nil
+		}
+
+		msg, ok := msgs[zone]
+		if !ok {
+			msg = new(dns.Msg)
+			msg.SetUpdate(zone)
+			msgs[zone] = msg
+		}
+
+		return msg, rdata.NewPTR(name, ttl, fqdn)
+	}
+
+	for _, addr := range remove {
+		msg, rr := message(addr)
+		if rr == nil {
+			return diags
+		}
+		msg.Remove([]dns.RR{rr})
+	}
+	for _, addr := range add {
+		msg, rr := message(addr)
+		if rr == nil {
+			return diags
+		}
+		msg.Insert([]dns.RR{rr})
+	}
+
+	zones := make([]string, 0, len(msgs))
+	for zone := range msgs {
+		zones = append(zones, zone)
+	}
+	sort.Strings(zones)
+
+	for _, zone := range zones {
+		r, err := exchange(msgs[zone], true, client)
+		if err != nil {
+			diags.AddError("Error updating PTR record:", err.Error())
+			return diags
+		}
+		if r.Rcode != dns.RcodeSuccess {
+			diags.AddError(fmt.Sprintf("Error updating PTR record: %v", r.Rcode),
+				fmt.Sprintf("%s in reverse zone %s", dns.RcodeToString[r.Rcode], zone))
+			return diags
+		}
+	}
+
+	return diags
+}
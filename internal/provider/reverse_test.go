@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/dnstypes"
+)
+
+func TestPtrChanges(t *testing.T) {
+	planned := []dnstypes.IPAddress{
+		dnstypes.NewIPAddressValue("192.0.2.1"),
+		dnstypes.NewIPAddressValue("2001:db8::2"),
+	}
+	current := []dnstypes.IPAddress{
+		dnstypes.NewIPAddressValue("192.0.2.1"),
+		dnstypes.NewIPAddressValue("192.0.2.3"),
+	}
+
+	testCases := map[string]struct {
+		plannedManaged bool
+		currentManaged bool
+		expectedAdd    []string
+		expectedRemove []string
+	}{
+		"managed": {
+			plannedManaged: true,
+			currentManaged: true,
+			expectedAdd:    []string{"2001:db8::2"},
+			expectedRemove: []string{"192.0.2.3"},
+		},
+		"enabled": {
+			plannedManaged: true,
+			expectedAdd:    []string{"192.0.2.1", "2001:db8::2"},
+		},
+		"disabled": {
+			currentManaged: true,
+			expectedRemove: []string{"192.0.2.1", "192.0.2.3"},
+		},
+		"unmanaged": {},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := ptrChanges(planned, current, testCase.plannedManaged, testCase.currentManaged)
+			if !reflect.DeepEqual(add, testCase.expectedAdd) {
+				t.Errorf("expected add %v, got %v", testCase.expectedAdd, add)
+			}
+			if !reflect.DeepEqual(remove, testCase.expectedRemove) {
+				t.Errorf("expected remove %v, got %v", testCase.expectedRemove, remove)
+			}
+		})
+	}
+}
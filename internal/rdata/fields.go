@@ -0,0 +1,226 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rdata
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/miekg/dns"
+)
+
+// Field is an rdata field of a record. Value is a string for names, text and
+// addresses, a uint64 for integers, and a []string for character strings and
+// type bitmaps, which hold type mnemonics.
+type Field struct {
+	Name  string
+	Value interface{}
+}
+
+var (
+	headerType = reflect.TypeOf(dns.RR_Header{})
+	ipType     = reflect.TypeOf(net.IP{})
+)
+
+// Fields returns the rdata fields of record in their presentation order,
+// named after the fields of its dns.RR struct in snake case, such as
+// preference and mx for MX records, or key_tag for DS records.
+func Fields(record dns.RR) ([]Field, error) {
+	var fields []Field
+
+	err := walkFields(record, func(name string, value reflect.Value) error {
+		v, err := fieldValue(value)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		fields = append(fields, Field{Name: name, Value: v})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s records are not supported: %w", dns.Type(record.Header().Rrtype).String(), err)
+	}
+
+	return fields, nil
+}
+
+// SetFields sets the rdata fields of record, named as returned by Fields.
+// Integers may also be given as strings. Every field must be set, and values
+// are only checked for their range, so the record should be validated by
+// parsing its presentation format.
+func SetFields(record dns.RR, values map[string]interface{}) error {
+	seen := make(map[string]bool, len(values))
+
+	err := walkFields(record, func(name string, field reflect.Value) error {
+		value, ok := values[name]
+		if !ok {
+			return fmt.Errorf("missing rdata field %q", name)
+		}
+		seen[name] = true
+
+		if err := setFieldValue(field, value); err != nil {
+			return fmt.Errorf("invalid rdata field %q: %w", name, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var unknown []string
+	for name := range values {
+		if !seen[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unsupported rdata fields for %s records: %s", dns.Type(record.Header().Rrtype).String(), strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
+
+// walkFields calls fn for each rdata field of record, including the fields of
+// embedded structs such as the DS struct of CDS records.
+func walkFields(record dns.RR, fn func(name string, value reflect.Value) error) error {
+	var walk func(v reflect.Value) error
+	walk = func(v reflect.Value) error {
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+
+			switch {
+			case field.Type == headerType:
+				continue
+			case field.Anonymous && field.Type.Kind() == reflect.Struct:
+				if err := walk(v.Field(i)); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err := fn(snakeCase(field.Name), v.Field(i)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return walk(reflect.ValueOf(record).Elem())
+}
+
+func fieldValue(v reflect.Value) (interface{}, error) {
+	switch {
+	case v.Type() == ipType:
+		if v.IsNil() {
+			return "", nil
+		}
+		return v.Interface().(net.IP).String(), nil
+	case v.Kind() == reflect.String:
+		return v.String(), nil
+	case v.Kind() == reflect.Uint8, v.Kind() == reflect.Uint16, v.Kind() == reflect.Uint32, v.Kind() == reflect.Uint64:
+		return v.Uint(), nil
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.String:
+		return append([]string{}, v.Interface().([]string)...), nil
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint16:
+		types := make([]string, 0, v.Len())
+		for _, t := range v.Interface().([]uint16) {
+			types = append(types, dns.Type(t).String())
+		}
+		return types, nil
+	}
+
+	return nil, fmt.Errorf("unsupported value of type %s", v.Type())
+}
+
+func setFieldValue(v reflect.Value, value interface{}) error {
+	switch {
+	case v.Type() == ipType:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("expected an IP address, got %T", value)
+		}
+		ip := net.ParseIP(s)
+		if ip == nil {
+			return fmt.Errorf("invalid IP address: %q", s)
+		}
+		if ip4 := ip.To4(); ip4 != nil && !strings.Contains(s, ":") {
+			ip = ip4
+		}
+		v.Set(reflect.ValueOf(ip))
+	case v.Kind() == reflect.String:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("expected a string, got %T", value)
+		}
+		v.SetString(s)
+	case v.Kind() == reflect.Uint8, v.Kind() == reflect.Uint16, v.Kind() == reflect.Uint32, v.Kind() == reflect.Uint64:
+		var n uint64
+		switch value := value.(type) {
+		case uint64:
+			n = value
+		case string:
+			var err error
+			if n, err = strconv.ParseUint(value, 10, 64); err != nil {
+				return fmt.Errorf("expected an integer, got %q", value)
+			}
+		default:
+			return fmt.Errorf("expected an integer, got %T", value)
+		}
+		if v.OverflowUint(n) {
+			return fmt.Errorf("%d is out of range", n)
+		}
+		v.SetUint(n)
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.String:
+		s, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("expected a list of strings, got %T", value)
+		}
+		v.Set(reflect.ValueOf(append([]string{}, s...)))
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint16:
+		s, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("expected a list of record types, got %T", value)
+		}
+		types := make([]uint16, 0, len(s))
+		for _, name := range s {
+			t, ok := dns.StringToType[strings.ToUpper(name)]
+			if !ok {
+				return fmt.Errorf("unknown record type: %q", name)
+			}
+			types = append(types, t)
+		}
+		v.Set(reflect.ValueOf(types))
+	default:
+		return fmt.Errorf("unsupported value of type %s", v.Type())
+	}
+
+	return nil
+}
+
+// snakeCase converts a Go field name such as KeyTag or HIT to key_tag or hit.
+func snakeCase(name string) string {
+	runes := []rune(name)
+
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prevLower := unicode.IsLower(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevLower || (unicode.IsUpper(runes[i-1]) && nextLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
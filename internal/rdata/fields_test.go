@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rdata
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/miekg/dns"
+)
+
+func TestFields(t *testing.T) {
+	testCases := map[string]struct {
+		record   string
+		expected []Field
+	}{
+		"A": {
+			record:   "foo.example.com. 300 IN A 192.168.0.1",
+			expected: []Field{{Name: "a", Value: "192.168.0.1"}},
+		},
+		"MX": {
+			record: "example.com. 300 IN MX 10 mail.example.com.",
+			expected: []Field{
+				{Name: "preference", Value: uint64(10)},
+				{Name: "mx", Value: "mail.example.com."},
+			},
+		},
+		"TXT": {
+			record:   `example.com. 300 IN TXT "hello world" "second"`,
+			expected: []Field{{Name: "txt", Value: []string{"hello world", "second"}}},
+		},
+		"CDS": {
+			record: "example.com. 300 IN CDS 60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118",
+			expected: []Field{
+				{Name: "key_tag", Value: uint64(60485)},
+				{Name: "algorithm", Value: uint64(5)},
+				{Name: "digest_type", Value: uint64(1)},
+				{Name: "digest", Value: "2BB183AF5F22588179A53B0A98631FAD1A292118"},
+			},
+		},
+		"NSEC": {
+			record: "example.com. 300 IN NSEC www.example.com. A NS SOA",
+			expected: []Field{
+				{Name: "next_domain", Value: "www.example.com."},
+				{Name: "type_bit_map", Value: []string{"A", "NS", "SOA"}},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rr, err := dns.NewRR(testCase.record)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			fields, err := Fields(rr)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(fields, testCase.expected) {
+				t.Fatalf("expected fields %v, got %v", testCase.expected, fields)
+			}
+
+			values := make(map[string]interface{}, len(fields))
+			for _, field := range fields {
+				values[field.Name] = field.Value
+			}
+
+			record := dns.TypeToRR[rr.Header().Rrtype]()
+			*record.Header() = *rr.Header()
+			if err := SetFields(record, values); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if record.String() != rr.String() {
+				t.Errorf("expected record %q, got %q", rr.String(), record.String())
+			}
+		})
+	}
+}
+
+func TestSetFieldsInvalid(t *testing.T) {
+	testCases := map[string]map[string]interface{}{
+		"missing": {
+			"preference": uint64(10),
+		},
+		"unknown": {
+			"preference": uint64(10),
+			"mx":         "mail.example.com.",
+			"weight":     uint64(1),
+		},
+		"out-of-range": {
+			"preference": uint64(65536),
+			"mx":         "mail.example.com.",
+		},
+		"wrong-type": {
+			"preference": uint64(10),
+			"mx":         []string{"mail.example.com."},
+		},
+	}
+
+	for name, values := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if err := SetFields(new(dns.MX), values); err == nil {
+				t.Error("expected error, got none")
+			}
+		})
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	for name, expected := range map[string]string{
+		"Mx":          "mx",
+		"KeyTag":      "key_tag",
+		"PublicKey":   "public_key",
+		"HIT":         "hit",
+		"TypeBitMap":  "type_bit_map",
+		"FingerPrint": "finger_print",
+	} {
+		if got := snakeCase(name); got != expected {
+			t.Errorf("expected %q for %q, got %q", expected, name, got)
+		}
+	}
+}
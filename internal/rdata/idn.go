@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rdata
+
+import (
+	"fmt"
+
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/idn"
+)
+
+// ToASCII converts the owner name of record, and the domain names in its rdata
+// for the record types managed by the provider, to their ASCII form.
+func ToASCII(record dns.RR) error {
+	hdr := record.Header()
+
+	name, err := toASCII(hdr.Name)
+	if err != nil {
+		return err
+	}
+	hdr.Name = name
+
+	switch rr := record.(type) {
+	case *dns.CNAME:
+		rr.Target, err = toASCII(rr.Target)
+	case *dns.NS:
+		rr.Ns, err = toASCII(rr.Ns)
+	case *dns.PTR:
+		rr.Ptr, err = toASCII(rr.Ptr)
+	case *dns.MX:
+		rr.Mx, err = toASCII(rr.Mx)
+	case *dns.SRV:
+		rr.Target, err = toASCII(rr.Target)
+	}
+
+	return err
+}
+
+func toASCII(name string) (string, error) {
+	converted, err := idn.ToASCII(name)
+	if err != nil {
+		return name, fmt.Errorf("invalid internationalized domain name %q: %w", name, err)
+	}
+
+	return converted, nil
+}
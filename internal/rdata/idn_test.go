@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rdata
+
+import (
+	"testing"
+
+	"github.com/miekg/dns"
+)
+
+func TestToASCII(t *testing.T) {
+	mustRR := func(rr dns.RR, err error) dns.RR {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		return rr
+	}
+
+	testCases := map[string]struct {
+		rr       dns.RR
+		expected string
+	}{
+		"A": {
+			rr:       mustRR(NewA("bücher.example.", 300, "192.168.0.1")),
+			expected: "xn--bcher-kva.example. 300 IN A 192.168.0.1",
+		},
+		"CNAME": {
+			rr:       NewCNAME("www.example.", 300, "bücher.example."),
+			expected: "www.example. 300 IN CNAME xn--bcher-kva.example.",
+		},
+		"NS": {
+			rr:       NewNS("bücher.example.", 300, "ns1.bücher.example."),
+			expected: "xn--bcher-kva.example. 300 IN NS ns1.xn--bcher-kva.example.",
+		},
+		"PTR": {
+			rr:       NewPTR("1.0.168.192.in-addr.arpa.", 300, "bücher.example."),
+			expected: "1.0.168.192.in-addr.arpa. 300 IN PTR xn--bcher-kva.example.",
+		},
+		"MX": {
+			rr:       mustRR(NewMX("bücher.example.", 300, 10, "mail.bücher.example.")),
+			expected: "xn--bcher-kva.example. 300 IN MX 10 mail.xn--bcher-kva.example.",
+		},
+		"SRV": {
+			rr:       mustRR(NewSRV("_sip._tcp.bücher.example.", 300, 10, 60, 5060, "sip.bücher.example.")),
+			expected: "_sip._tcp.xn--bcher-kva.example. 300 IN SRV 10 60 5060 sip.xn--bcher-kva.example.",
+		},
+		"ascii": {
+			rr:       NewCNAME("WWW.example.", 300, "Bar.example."),
+			expected: "WWW.example. 300 IN CNAME Bar.example.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if err := ToASCII(testCase.rr); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			expected, err := dns.NewRR(testCase.expected)
+			if err != nil {
+				t.Fatalf("error parsing expected record: %s", err)
+			}
+
+			if testCase.rr.String() != expected.String() {
+				t.Errorf("expected %q, got %q", expected, testCase.rr)
+			}
+		})
+	}
+
+	if err := ToASCII(NewCNAME("www.example.", 300, "aש.example.")); err == nil {
+		t.Error("expected error for invalid internationalized domain name")
+	}
+}
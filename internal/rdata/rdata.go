@@ -0,0 +1,338 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package rdata converts between the typed dns.RR structs and the plain values
+// stored in resource attributes, so records never have to be assembled from or
+// parsed back into their presentation format.
+package rdata
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net"
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+// Header returns the header of an IN class record.
+func Header(name string, rrType uint16, ttl int64) dns.RR_Header {
+	return dns.RR_Header{
+		Name:   name,
+		Rrtype: rrType,
+		Class:  dns.ClassINET,
+		Ttl:    uint32(ttl),
+	}
+}
+
+// NewEmpty returns a record without rdata, suitable to remove a whole RRset
+// with dns.Msg.RemoveRRset.
+func NewEmpty(name string, rrType uint16) dns.RR {
+	return &dns.ANY{Hdr: Header(name, rrType, 0)}
+}
+
+// NewA returns an A record for the IPv4 address addr.
+func NewA(name string, ttl int64, addr string) (*dns.A, error) {
+	ip := net.ParseIP(addr)
+	if ip == nil || ip.To4() == nil {
+		return nil, fmt.Errorf("invalid IPv4 address: %q", addr)
+	}
+
+	return &dns.A{Hdr: Header(name, dns.TypeA, ttl), A: ip.To4()}, nil
+}
+
+// NewAAAA returns an AAAA record for the IPv6 address addr.
+func NewAAAA(name string, ttl int64, addr string) (*dns.AAAA, error) {
+	ip := net.ParseIP(addr)
+	if ip == nil || !strings.Contains(addr, ":") {
+		return nil, fmt.Errorf("invalid IPv6 address: %q", addr)
+	}
+
+	return &dns.AAAA{Hdr: Header(name, dns.TypeAAAA, ttl), AAAA: ip}, nil
+}
+
+// NewCNAME returns a CNAME record pointing to target.
+func NewCNAME(name string, ttl int64, target string) *dns.CNAME {
+	return &dns.CNAME{Hdr: Header(name, dns.TypeCNAME, ttl), Target: dns.Fqdn(target)}
+}
+
+// NewNS returns an NS record delegating to nameserver.
+func NewNS(name string, ttl int64, nameserver string) *dns.NS {
+	return &dns.NS{Hdr: Header(name, dns.TypeNS, ttl), Ns: dns.Fqdn(nameserver)}
+}
+
+// NewPTR returns a PTR record pointing to target.
+func NewPTR(name string, ttl int64, target string) *dns.PTR {
+	return &dns.PTR{Hdr: Header(name, dns.TypePTR, ttl), Ptr: dns.Fqdn(target)}
+}
+
+// NewMX returns an MX record for exchange with the given preference.
+func NewMX(name string, ttl int64, preference int64, exchange string) (*dns.MX, error) {
+	pref, err := uint16Value("preference", preference)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dns.MX{Hdr: Header(name, dns.TypeMX, ttl), Preference: pref, Mx: dns.Fqdn(exchange)}, nil
+}
+
+// NewSRV returns an SRV record for target.
+func NewSRV(name string, ttl int64, priority, weight, port int64, target string) (*dns.SRV, error) {
+	prio, err := uint16Value("priority", priority)
+	if err != nil {
+		return nil, err
+	}
+	w, err := uint16Value("weight", weight)
+	if err != nil {
+		return nil, err
+	}
+	p, err := uint16Value("port", port)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dns.SRV{Hdr: Header(name, dns.TypeSRV, ttl), Priority: prio, Weight: w, Port: p, Target: dns.Fqdn(target)}, nil
+}
+
+// NewTXT returns a TXT record made of the raw character-strings chunks.
+func NewTXT(name string, ttl int64, chunks []string) *dns.TXT {
+	rr := &dns.TXT{Hdr: Header(name, dns.TypeTXT, ttl)}
+	for _, chunk := range chunks {
+		rr.Txt = append(rr.Txt, escapeTXT(chunk))
+	}
+	return rr
+}
+
+// NewSOA returns an SOA record for the zone name.
+func NewSOA(name string, ttl int64, mname, rname string, serial, refresh, retry, expire, minimum int64) (*dns.SOA, error) {
+	values := make([]uint32, 0, 5)
+	for _, field := range []struct {
+		name  string
+		value int64
+	}{
+		{"serial", serial},
+		{"refresh", refresh},
+		{"retry", retry},
+		{"expire", expire},
+		{"minimum", minimum},
+	} {
+		value, err := uint32Value(field.name, field.value)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+
+	return &dns.SOA{
+		Hdr:     Header(name, dns.TypeSOA, ttl),
+		Ns:      dns.Fqdn(mname),
+		Mbox:    dns.Fqdn(rname),
+		Serial:  values[0],
+		Refresh: values[1],
+		Retry:   values[2],
+		Expire:  values[3],
+		Minttl:  values[4],
+	}, nil
+}
+
+// NewDS returns a DS record, or a CDS record if rrType is dns.TypeCDS.
+func NewDS(name string, rrType uint16, ttl int64, keyTag, algorithm, digestType int64, digest string) (dns.RR, error) {
+	tag, err := uint16Value("key_tag", keyTag)
+	if err != nil {
+		return nil, err
+	}
+	alg, err := uint8Value("algorithm", algorithm)
+	if err != nil {
+		return nil, err
+	}
+	dt, err := uint8Value("digest_type", digestType)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := hex.DecodeString(digest); err != nil {
+		return nil, fmt.Errorf("invalid digest: %q", digest)
+	}
+
+	ds := dns.DS{Hdr: Header(name, rrType, ttl), KeyTag: tag, Algorithm: alg, DigestType: dt, Digest: strings.ToLower(digest)}
+	if rrType == dns.TypeCDS {
+		return &dns.CDS{DS: ds}, nil
+	}
+	return &ds, nil
+}
+
+// NewDNSKEY returns a DNSKEY record, or a CDNSKEY record if rrType is
+// dns.TypeCDNSKEY.
+func NewDNSKEY(name string, rrType uint16, ttl int64, flags, algorithm int64, publicKey string) (dns.RR, error) {
+	f, err := uint16Value("flags", flags)
+	if err != nil {
+		return nil, err
+	}
+	alg, err := uint8Value("algorithm", algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := base64.StdEncoding.DecodeString(publicKey); err != nil {
+		return nil, fmt.Errorf("invalid public key: %q", publicKey)
+	}
+
+	key := dns.DNSKEY{Hdr: Header(name, rrType, ttl), Flags: f, Protocol: 3, Algorithm: alg, PublicKey: publicKey}
+	if rrType == dns.TypeCDNSKEY {
+		return &dns.CDNSKEY{DNSKEY: key}, nil
+	}
+	return &key, nil
+}
+
+// A returns the address and TTL of an A record.
+func A(record dns.RR) (string, int, error) {
+	rr, ok := record.(*dns.A)
+	if !ok {
+		return "", 0, fmt.Errorf("didn't get a A record")
+	}
+
+	return rr.A.String(), int(rr.Hdr.Ttl), nil
+}
+
+// AAAA returns the address and TTL of an AAAA record.
+func AAAA(record dns.RR) (string, int, error) {
+	rr, ok := record.(*dns.AAAA)
+	if !ok {
+		return "", 0, fmt.Errorf("didn't get a AAAA record")
+	}
+
+	return rr.AAAA.String(), int(rr.Hdr.Ttl), nil
+}
+
+// CNAME returns the target and TTL of a CNAME record.
+func CNAME(record dns.RR) (string, int, error) {
+	rr, ok := record.(*dns.CNAME)
+	if !ok {
+		return "", 0, fmt.Errorf("didn't get a CNAME record")
+	}
+
+	return rr.Target, int(rr.Hdr.Ttl), nil
+}
+
+// NS returns the nameserver and TTL of an NS record.
+func NS(record dns.RR) (string, int, error) {
+	rr, ok := record.(*dns.NS)
+	if !ok {
+		return "", 0, fmt.Errorf("didn't get a NS record")
+	}
+
+	return rr.Ns, int(rr.Hdr.Ttl), nil
+}
+
+// PTR returns the target and TTL of a PTR record.
+func PTR(record dns.RR) (string, int, error) {
+	rr, ok := record.(*dns.PTR)
+	if !ok {
+		return "", 0, fmt.Errorf("didn't get a PTR record")
+	}
+
+	return rr.Ptr, int(rr.Hdr.Ttl), nil
+}
+
+// MX returns the preference, exchange and TTL of an MX record.
+func MX(record dns.RR) (int64, string, int, error) {
+	rr, ok := record.(*dns.MX)
+	if !ok {
+		return 0, "", 0, fmt.Errorf("didn't get an MX record")
+	}
+
+	return int64(rr.Preference), rr.Mx, int(rr.Hdr.Ttl), nil
+}
+
+// SRV returns the priority, weight, port, target and TTL of an SRV record.
+func SRV(record dns.RR) (int64, int64, int64, string, int, error) {
+	rr, ok := record.(*dns.SRV)
+	if !ok {
+		return 0, 0, 0, "", 0, fmt.Errorf("didn't get an SRV record")
+	}
+
+	return int64(rr.Priority), int64(rr.Weight), int64(rr.Port), rr.Target, int(rr.Hdr.Ttl), nil
+}
+
+// TXT returns the raw character-strings and TTL of a TXT record.
+func TXT(record dns.RR) ([]string, int, error) {
+	rr, ok := record.(*dns.TXT)
+	if !ok {
+		return nil, 0, fmt.Errorf("didn't get an TXT record")
+	}
+
+	chunks := make([]string, 0, len(rr.Txt))
+	for _, s := range rr.Txt {
+		chunks = append(chunks, unescapeTXT(s))
+	}
+
+	return chunks, int(rr.Hdr.Ttl), nil
+}
+
+// DS returns the key tag, algorithm, digest type, digest and TTL of a DS or
+// CDS record.
+func DS(record dns.RR) (int64, int64, int64, string, int, error) {
+	var rr *dns.DS
+	switch r := record.(type) {
+	case *dns.DS:
+		rr = r
+	case *dns.CDS:
+		rr = &r.DS
+	default:
+		return 0, 0, 0, "", 0, fmt.Errorf("didn't get a DS record")
+	}
+
+	return int64(rr.KeyTag), int64(rr.Algorithm), int64(rr.DigestType), strings.ToLower(rr.Digest), int(rr.Hdr.Ttl), nil
+}
+
+// DNSKEY returns the flags, algorithm, public key and TTL of a DNSKEY or
+// CDNSKEY record.
+func DNSKEY(record dns.RR) (int64, int64, string, int, error) {
+	var rr *dns.DNSKEY
+	switch r := record.(type) {
+	case *dns.DNSKEY:
+		rr = r
+	case *dns.CDNSKEY:
+		rr = &r.DNSKEY
+	default:
+		return 0, 0, "", 0, fmt.Errorf("didn't get a DNSKEY record")
+	}
+
+	return int64(rr.Flags), int64(rr.Algorithm), rr.PublicKey, int(rr.Hdr.Ttl), nil
+}
+
+func uint32Value(field string, value int64) (uint32, error) {
+	if value < 0 || value > math.MaxUint32 {
+		return 0, fmt.Errorf("%s must be between 0 and %d, got %d", field, uint32(math.MaxUint32), value)
+	}
+
+	return uint32(value), nil
+}
+
+func uint16Value(field string, value int64) (uint16, error) {
+	if value < 0 || value > math.MaxUint16 {
+		return 0, fmt.Errorf("%s must be between 0 and %d, got %d", field, math.MaxUint16, value)
+	}
+
+	return uint16(value), nil
+}
+
+func uint8Value(field string, value int64) (uint8, error) {
+	if value < 0 || value > math.MaxUint8 {
+		return 0, fmt.Errorf("%s must be between 0 and %d, got %d", field, math.MaxUint8, value)
+	}
+
+	return uint8(value), nil
+}
+
+// Text returns the presentation format of the rdata of record, that is the
+// record without its owner name, TTL, class and type.
+func Text(record dns.RR) string {
+	// The records of unknown types are written with a header of their own,
+	// in the generic format of RFC 3597
+	if unknown, ok := record.(*dns.RFC3597); ok {
+		return strings.TrimSpace(fmt.Sprintf(`\# %d %s`, len(unknown.Rdata)/2, unknown.Rdata))
+	}
+
+	return strings.TrimPrefix(record.String(), record.Header().String())
+}
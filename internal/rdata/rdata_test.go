@@ -0,0 +1,213 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rdata
+
+import (
+	"testing"
+
+	"github.com/miekg/dns"
+)
+
+func TestNew(t *testing.T) {
+	mustRR := func(rr dns.RR, err error) dns.RR {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		return rr
+	}
+
+	testCases := map[string]struct {
+		rr       dns.RR
+		expected string
+	}{
+		"A": {
+			rr:       mustRR(NewA("foo.example.com.", 300, "192.168.0.1")),
+			expected: "foo.example.com. 300 IN A 192.168.0.1",
+		},
+		"AAAA": {
+			rr:       mustRR(NewAAAA("foo.example.com.", 300, "fdd5:e282::dead:beef:cafe:babe")),
+			expected: "foo.example.com. 300 IN AAAA fdd5:e282::dead:beef:cafe:babe",
+		},
+		"CNAME": {
+			rr:       NewCNAME("foo.example.com.", 300, "bar.example.com."),
+			expected: "foo.example.com. 300 IN CNAME bar.example.com.",
+		},
+		"CNAME-relative": {
+			rr:       NewCNAME("foo.example.com.", 300, "bar"),
+			expected: "foo.example.com. 300 IN CNAME bar.",
+		},
+		"NS": {
+			rr:       NewNS("foo.example.com.", 300, "ns1.example.com."),
+			expected: "foo.example.com. 300 IN NS ns1.example.com.",
+		},
+		"PTR": {
+			rr:       NewPTR("1.0.168.192.in-addr.arpa.", 300, "foo.example.com."),
+			expected: "1.0.168.192.in-addr.arpa. 300 IN PTR foo.example.com.",
+		},
+		"MX": {
+			rr:       mustRR(NewMX("example.com.", 300, 10, "smtp.example.com.")),
+			expected: "example.com. 300 IN MX 10 smtp.example.com.",
+		},
+		"SRV": {
+			rr:       mustRR(NewSRV("_sip._tcp.example.com.", 300, 10, 60, 5060, "bigbox.example.com.")),
+			expected: "_sip._tcp.example.com. 300 IN SRV 10 60 5060 bigbox.example.com.",
+		},
+		"SOA": {
+			rr:       mustRR(NewSOA("example.com.", 3600, "ns1.example.com", "hostmaster.example.com.", 2026101801, 7200, 900, 1209600, 300)),
+			expected: "example.com. 3600 IN SOA ns1.example.com. hostmaster.example.com. 2026101801 7200 900 1209600 300",
+		},
+		"DS": {
+			rr:       mustRR(NewDS("dskey.example.com.", dns.TypeDS, 300, 60485, 5, 1, "2BB183AF5F22588179A53B0A98631FAD1A292118")),
+			expected: "dskey.example.com. 300 IN DS 60485 5 1 2bb183af5f22588179a53b0a98631fad1a292118",
+		},
+		"CDS": {
+			rr:       mustRR(NewDS("example.com.", dns.TypeCDS, 300, 0, 0, 0, "00")),
+			expected: "example.com. 300 IN CDS 0 0 0 00",
+		},
+		"DNSKEY": {
+			rr:       mustRR(NewDNSKEY("example.com.", dns.TypeDNSKEY, 300, 257, 15, "l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4=")),
+			expected: "example.com. 300 IN DNSKEY 257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4=",
+		},
+		"CDNSKEY": {
+			rr:       mustRR(NewDNSKEY("example.com.", dns.TypeCDNSKEY, 300, 257, 15, "l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4=")),
+			expected: "example.com. 300 IN CDNSKEY 257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4=",
+		},
+		"TXT": {
+			rr:       NewTXT("foo.example.com.", 300, []string{`say "hi"`, "bar"}),
+			expected: `foo.example.com. 300 IN TXT "say \"hi\"" "bar"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			expected, err := dns.NewRR(testCase.expected)
+			if err != nil {
+				t.Fatalf("error parsing expected record: %s", err)
+			}
+
+			if !dns.IsDuplicate(testCase.rr, expected) || testCase.rr.Header().Ttl != expected.Header().Ttl {
+				t.Errorf("expected %q, got %q", expected, testCase.rr)
+			}
+		})
+	}
+}
+
+func TestNewInvalid(t *testing.T) {
+	if _, err := NewA("foo.example.com.", 300, "fdd5:e282::1"); err == nil {
+		t.Error("expected error for IPv6 address in A record")
+	}
+
+	if _, err := NewAAAA("foo.example.com.", 300, "192.168.0.1"); err == nil {
+		t.Error("expected error for IPv4 address in AAAA record")
+	}
+
+	if _, err := NewMX("example.com.", 300, 65536, "smtp.example.com."); err == nil {
+		t.Error("expected error for out of range preference")
+	}
+
+	if _, err := NewSRV("_sip._tcp.example.com.", 300, 10, -1, 5060, "bigbox.example.com."); err == nil {
+		t.Error("expected error for out of range weight")
+	}
+
+	if _, err := NewSOA("example.com.", 3600, "ns1.example.com.", "hostmaster.example.com.", 1<<32, 7200, 900, 1209600, 300); err == nil {
+		t.Error("expected error for out of range serial")
+	}
+
+	if _, err := NewDS("dskey.example.com.", dns.TypeDS, 300, 60485, 256, 1, "2bb183af"); err == nil {
+		t.Error("expected error for out of range algorithm")
+	}
+
+	if _, err := NewDS("dskey.example.com.", dns.TypeDS, 300, 60485, 5, 1, "not hex"); err == nil {
+		t.Error("expected error for invalid digest")
+	}
+
+	if _, err := NewDNSKEY("example.com.", dns.TypeDNSKEY, 300, 257, 15, "not base64!"); err == nil {
+		t.Error("expected error for invalid public key")
+	}
+}
+
+func TestNewEmpty(t *testing.T) {
+	msg := new(dns.Msg)
+	msg.SetUpdate("example.com.")
+	msg.RemoveRRset([]dns.RR{NewEmpty("foo.example.com.", dns.TypeTXT)})
+
+	hdr := msg.Ns[0].Header()
+	if hdr.Name != "foo.example.com." || hdr.Rrtype != dns.TypeTXT || hdr.Class != dns.ClassANY || hdr.Ttl != 0 {
+		t.Errorf("unexpected RRset removal: %s", msg.Ns[0])
+	}
+}
+
+func TestText(t *testing.T) {
+	mx, _ := NewMX("example.com.", 300, 10, "smtp.example.com.")
+	if text := Text(mx); text != "10 smtp.example.com." {
+		t.Errorf("unexpected MX rdata: %q", text)
+	}
+
+	txt := NewTXT("foo.example.com.", 300, []string{`say "hi"`, "bar"})
+	if text := Text(txt); text != `"say \"hi\"" "bar"` {
+		t.Errorf("unexpected TXT rdata: %q", text)
+	}
+
+	unknown, _ := dns.NewRR(`example.com. 300 IN TYPE65534 \# 5 0d2a3c0001`)
+	if text := Text(unknown); text != `\# 5 0d2a3c0001` {
+		t.Errorf("unexpected TYPE65534 rdata: %q", text)
+	}
+
+	empty, _ := dns.NewRR(`example.com. 300 IN TYPE65534 \# 0`)
+	if text := Text(empty); text != `\# 0` {
+		t.Errorf("unexpected empty TYPE65534 rdata: %q", text)
+	}
+}
+
+func TestAccessors(t *testing.T) {
+	a, _ := NewA("foo.example.com.", 300, "192.168.0.1")
+	if addr, ttl, err := A(a); err != nil || addr != "192.168.0.1" || ttl != 300 {
+		t.Errorf("unexpected A result: %s, %d, %v", addr, ttl, err)
+	}
+
+	aaaa, _ := NewAAAA("foo.example.com.", 300, "FDD5:E282:0000:0000:1234:5678:CAFE:9012")
+	if addr, ttl, err := AAAA(aaaa); err != nil || addr != "fdd5:e282::1234:5678:cafe:9012" || ttl != 300 {
+		t.Errorf("unexpected AAAA result: %s, %d, %v", addr, ttl, err)
+	}
+
+	if target, ttl, err := CNAME(NewCNAME("foo.example.com.", 300, "bar.example.com.")); err != nil || target != "bar.example.com." || ttl != 300 {
+		t.Errorf("unexpected CNAME result: %s, %d, %v", target, ttl, err)
+	}
+
+	if ns, ttl, err := NS(NewNS("foo.example.com.", 300, "ns1.example.com.")); err != nil || ns != "ns1.example.com." || ttl != 300 {
+		t.Errorf("unexpected NS result: %s, %d, %v", ns, ttl, err)
+	}
+
+	if target, ttl, err := PTR(NewPTR("foo.example.com.", 300, "bar.example.com.")); err != nil || target != "bar.example.com." || ttl != 300 {
+		t.Errorf("unexpected PTR result: %s, %d, %v", target, ttl, err)
+	}
+
+	mx, _ := NewMX("example.com.", 300, 10, "smtp.example.com.")
+	if pref, exchange, ttl, err := MX(mx); err != nil || pref != 10 || exchange != "smtp.example.com." || ttl != 300 {
+		t.Errorf("unexpected MX result: %d, %s, %d, %v", pref, exchange, ttl, err)
+	}
+
+	srv, _ := NewSRV("_sip._tcp.example.com.", 300, 10, 60, 5060, "bigbox.example.com.")
+	if prio, weight, port, target, ttl, err := SRV(srv); err != nil || prio != 10 || weight != 60 || port != 5060 || target != "bigbox.example.com." || ttl != 300 {
+		t.Errorf("unexpected SRV result: %d, %d, %d, %s, %d, %v", prio, weight, port, target, ttl, err)
+	}
+
+	cds, _ := NewDS("dskey.example.com.", dns.TypeCDS, 300, 60485, 5, 1, "2BB183AF")
+	if tag, alg, digestType, digest, ttl, err := DS(cds); err != nil || tag != 60485 || alg != 5 || digestType != 1 || digest != "2bb183af" || ttl != 300 {
+		t.Errorf("unexpected CDS result: %d, %d, %d, %s, %d, %v", tag, alg, digestType, digest, ttl, err)
+	}
+
+	key, _ := NewDNSKEY("example.com.", dns.TypeDNSKEY, 300, 257, 15, "l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4=")
+	if flags, alg, publicKey, ttl, err := DNSKEY(key); err != nil || flags != 257 || alg != 15 || publicKey != "l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4=" || ttl != 300 {
+		t.Errorf("unexpected DNSKEY result: %d, %d, %s, %d, %v", flags, alg, publicKey, ttl, err)
+	}
+
+	if _, _, _, _, err := DNSKEY(cds); err == nil {
+		t.Error("expected error reading CDS record as DNSKEY record")
+	}
+
+	if _, _, err := A(NewCNAME("foo.example.com.", 300, "bar.example.com.")); err == nil {
+		t.Error("expected error reading CNAME record as A record")
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rdata
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxTXTStringLength is the longest character-string a TXT record can hold,
+// see RFC 1035 section 3.3.
+const MaxTXTStringLength = 255
+
+// SplitTXT splits a TXT value into character-strings of at most 255 bytes. A
+// multi-byte UTF-8 sequence is never split across two character-strings.
+func SplitTXT(value string) []string {
+	if len(value) <= MaxTXTStringLength {
+		return []string{value}
+	}
+
+	var chunks []string
+	for len(value) > MaxTXTStringLength {
+		end := MaxTXTStringLength
+		for end > 0 && !utf8.RuneStart(value[end]) {
+			end--
+		}
+		if end == 0 {
+			end = MaxTXTStringLength
+		}
+		chunks = append(chunks, value[:end])
+		value = value[end:]
+	}
+	if value != "" {
+		chunks = append(chunks, value)
+	}
+
+	return chunks
+}
+
+// escapeTXT converts a raw character-string into the presentation format that
+// dns.TXT expects, escaping quotes, backslashes and non-printable bytes.
+func escapeTXT(value string) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < ' ' || c > '~':
+			fmt.Fprintf(&b, "\\%03d", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// unescapeTXT is the inverse of escapeTXT and converts a character-string as
+// found in dns.TXT back into its raw value.
+func unescapeTXT(value string) string {
+	if !strings.Contains(value, "\\") {
+		return value
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c != '\\' || i+1 == len(value) {
+			b.WriteByte(c)
+			continue
+		}
+		if i+3 < len(value) && isDigit(value[i+1]) && isDigit(value[i+2]) && isDigit(value[i+3]) {
+			n := int(value[i+1]-'0')*100 + int(value[i+2]-'0')*10 + int(value[i+3]-'0')
+			if n <= 255 {
+				b.WriteByte(byte(n))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(value[i+1])
+		i++
+	}
+	return b.String()
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rdata
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/miekg/dns"
+)
+
+func TestSplitTXT(t *testing.T) {
+	testCases := map[string]struct {
+		input    string
+		expected []string
+	}{
+		"empty": {
+			input:    "",
+			expected: []string{""},
+		},
+		"short": {
+			input:    "foo",
+			expected: []string{"foo"},
+		},
+		"exact": {
+			input:    strings.Repeat("a", 255),
+			expected: []string{strings.Repeat("a", 255)},
+		},
+		"long": {
+			input:    strings.Repeat("a", 600),
+			expected: []string{strings.Repeat("a", 255), strings.Repeat("a", 255), strings.Repeat("a", 90)},
+		},
+		"multi-byte": {
+			input:    strings.Repeat("a", 254) + "é" + "b",
+			expected: []string{strings.Repeat("a", 254), "éb"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := SplitTXT(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestEscapeTXT(t *testing.T) {
+	testCases := map[string]struct {
+		input    string
+		expected string
+	}{
+		"plain": {
+			input:    "v=spf1 -all",
+			expected: "v=spf1 -all",
+		},
+		"quote": {
+			input:    `say "hello"`,
+			expected: `say \"hello\"`,
+		},
+		"backslash": {
+			input:    `a\b`,
+			expected: `a\\b`,
+		},
+		"non-printable": {
+			input:    "a\tb\x00",
+			expected: `a\009b\000`,
+		},
+		"utf-8": {
+			input:    "é",
+			expected: `\195\169`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := escapeTXT(testCase.input)
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+
+			if got := unescapeTXT(got); got != testCase.input {
+				t.Errorf("expected round trip to %q, got %q", testCase.input, got)
+			}
+		})
+	}
+}
+
+func TestTXTWireRoundTrip(t *testing.T) {
+	chunks := []string{`quote " and backslash \ `, "tab\tand é", strings.Repeat("x", 255)}
+
+	msg := new(dns.Msg)
+	msg.SetQuestion("example.com.", dns.TypeTXT)
+	msg.Answer = []dns.RR{NewTXT("example.com.", 300, chunks)}
+
+	packed, err := msg.Pack()
+	if err != nil {
+		t.Fatalf("error packing message: %s", err)
+	}
+
+	unpacked := new(dns.Msg)
+	if err := unpacked.Unpack(packed); err != nil {
+		t.Fatalf("error unpacking message: %s", err)
+	}
+
+	got, _, err := TXT(unpacked.Answer[0])
+	if err != nil {
+		t.Fatalf("error reading record: %s", err)
+	}
+
+	if diff := cmp.Diff(got, chunks); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnsvalidator
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/miekg/dns"
+)
+
+var _ validator.Object = digestValidator{}
+
+// digestLengths are the lengths in octets of the digests of the known DS
+// digest types. Digest type 0 is only used by CDS records requesting the
+// removal of the DS records, with a single zero octet as digest.
+var digestLengths = map[uint8]int{
+	0:          1,
+	dns.SHA1:   20,
+	dns.SHA256: 32,
+	dns.GOST94: 32,
+	dns.SHA384: 48,
+}
+
+// digestValidator validates that the digest attribute of an object matches
+// the length of its digest_type attribute.
+type digestValidator struct{}
+
+func (validator digestValidator) Description(ctx context.Context) string {
+	return "digest must match the length of digest_type"
+}
+
+func (validator digestValidator) MarkdownDescription(ctx context.Context) string {
+	return "`digest` must match the length of `digest_type`"
+}
+
+func (validator digestValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	// Only validate the attribute configuration value if it is known.
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attributes := req.ConfigValue.Attributes()
+
+	digestType, ok := attributes["digest_type"].(basetypes.Int64Value)
+	if !ok || digestType.IsNull() || digestType.IsUnknown() {
+		return
+	}
+
+	digestValuable, ok := attributes["digest"].(basetypes.StringValuable)
+	if !ok {
+		return
+	}
+
+	digest, diags := digestValuable.ToStringValue(ctx)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() || digest.IsNull() || digest.IsUnknown() {
+		return
+	}
+
+	if digestType.ValueInt64() < 0 || digestType.ValueInt64() > math.MaxUint8 {
+		return
+	}
+
+	length, ok := digestLengths[uint8(digestType.ValueInt64())]
+	if !ok {
+		return
+	}
+
+	if len(digest.ValueString()) != 2*length {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtName("digest"),
+			"Invalid Attribute Value Length",
+			fmt.Sprintf("Attribute %s must be %d hexadecimal digits long for digest type %d, got: %d",
+				req.Path.AtName("digest"), 2*length, digestType.ValueInt64(), len(digest.ValueString())),
+		)
+	}
+}
+
+// IsDigestValid returns a validator for DS record objects which ensures that
+// the configured digest has the length required by the configured digest
+// type, for the digest types of RFC 3658, RFC 4509, RFC 5933 and RFC 6605.
+// Digests of other digest types are not checked.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func IsDigestValid() validator.Object {
+	return digestValidator{}
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnsvalidator
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIsDigestValid(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{
+		"digest_type": types.Int64Type,
+		"digest":      types.StringType,
+	}
+
+	ds := func(digestType types.Int64, digest types.String) types.Object {
+		return types.ObjectValueMust(attrTypes, map[string]attr.Value{
+			"digest_type": digestType,
+			"digest":      digest,
+		})
+	}
+
+	type testCase struct {
+		val         types.Object
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"object unknown": {
+			val:         types.ObjectUnknown(attrTypes),
+			expectError: false,
+		},
+		"object null": {
+			val:         types.ObjectNull(attrTypes),
+			expectError: false,
+		},
+		"digest type unknown": {
+			val:         ds(types.Int64Unknown(), types.StringValue("00")),
+			expectError: false,
+		},
+		"digest unknown": {
+			val:         ds(types.Int64Value(2), types.StringUnknown()),
+			expectError: false,
+		},
+		"sha1": {
+			val:         ds(types.Int64Value(1), types.StringValue(strings.Repeat("ab", 20))),
+			expectError: false,
+		},
+		"sha256": {
+			val:         ds(types.Int64Value(2), types.StringValue(strings.Repeat("AB", 32))),
+			expectError: false,
+		},
+		"sha384": {
+			val:         ds(types.Int64Value(4), types.StringValue(strings.Repeat("ab", 48))),
+			expectError: false,
+		},
+		"sha256 too short": {
+			val:         ds(types.Int64Value(2), types.StringValue(strings.Repeat("ab", 20))),
+			expectError: true,
+		},
+		"sha384 too long": {
+			val:         ds(types.Int64Value(4), types.StringValue(strings.Repeat("ab", 64))),
+			expectError: true,
+		},
+		"delete": {
+			val:         ds(types.Int64Value(0), types.StringValue("00")),
+			expectError: false,
+		},
+		"unknown digest type": {
+			val:         ds(types.Int64Value(200), types.StringValue("abcd")),
+			expectError: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			request := validator.ObjectRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+
+			response := validator.ObjectResponse{}
+			IsDigestValid().ValidateObject(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnsvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = hostnameValidator{}
+
+// hostnameValidator validates if the provided value is a host name, as
+// required for the targets of MX, NS and SRV records.
+type hostnameValidator struct{}
+
+func (validator hostnameValidator) Description(ctx context.Context) string {
+	return "value must be a host name"
+}
+
+func (validator hostnameValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+func (validator hostnameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// Only validate the attribute configuration value if it is known.
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	validateName(req, resp, "Host name", nameRules{hostname: true})
+}
+
+// IsHostnameValid returns an AttributeValidator which ensures that any
+// configured attribute value:
+//
+//   - Is a valid internationalized domain name, if it contains non-ASCII
+//     characters.
+//   - Has no label longer than 63 octets and is no longer than 253
+//     characters, not counting the trailing dot.
+//   - Contains only letters, digits and hyphens, so no wildcards or
+//     underscores.
+//
+// The root name "." is accepted, as used by null MX and SRV records to signal
+// that a service is not available. Whitespace and other syntax errors are
+// reported by the attribute type.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func IsHostnameValid() validator.String {
+	return hostnameValidator{}
+}
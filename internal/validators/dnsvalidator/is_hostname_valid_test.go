@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnsvalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIsHostnameValid(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"string unknown": {
+			val:         types.StringUnknown(),
+			expectError: false,
+		},
+		"string null": {
+			val:         types.StringNull(),
+			expectError: false,
+		},
+		"fully qualified": {
+			val:         types.StringValue("mail.example.com."),
+			expectError: false,
+		},
+		"without trailing dot": {
+			val:         types.StringValue("mail.example.com"),
+			expectError: false,
+		},
+		"root": {
+			val:         types.StringValue("."),
+			expectError: false,
+		},
+		"a-label": {
+			val:         types.StringValue("mail.xn--bcher-kva.example."),
+			expectError: false,
+		},
+		"internationalized domain name": {
+			val:         types.StringValue("mail.bücher.example."),
+			expectError: false,
+		},
+		"invalid internationalized domain name": {
+			val:         types.StringValue("mail.aש.example."),
+			expectError: true,
+		},
+		"underscore": {
+			val:         types.StringValue("_mail.example.com."),
+			expectError: true,
+		},
+		"wildcard": {
+			val:         types.StringValue("*.example.com."),
+			expectError: true,
+		},
+		"trailing hyphen": {
+			val:         types.StringValue("mail-.example.com."),
+			expectError: true,
+		},
+		"escaped dot": {
+			val:         types.StringValue(`mail\.1.example.com.`),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+
+			response := validator.StringResponse{}
+			IsHostnameValid().ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnsvalidator
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/dnstypes"
+)
+
+var _ validator.String = ipAddressValidator{}
+
+// ipAddressValidator validates if the provided value is an IPv4 or, if ipv6 is
+// set, an IPv6 address.
+type ipAddressValidator struct {
+	ipv6 bool
+}
+
+func (validator ipAddressValidator) Description(ctx context.Context) string {
+	if validator.ipv6 {
+		return "value must be an IPv6 address"
+	}
+	return "value must be an IPv4 address"
+}
+
+func (validator ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+func (validator ipAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// Only validate the attribute configuration value if it is known.
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	ip := dnstypes.ParseIP(value)
+
+	if validator.ipv6 && (ip == nil || !strings.Contains(value, ":")) {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			"must be an IPv6 address",
+			value,
+		))
+	}
+	if !validator.ipv6 && (ip == nil || ip.To4() == nil || strings.Contains(value, ":")) {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			"must be an IPv4 address",
+			value,
+		))
+	}
+}
+
+// IsIPv4AddressValid returns an AttributeValidator which ensures that any
+// configured attribute value is an IPv4 address. Addresses with leading zeros
+// are accepted for compatibility with existing configurations.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func IsIPv4AddressValid() validator.String {
+	return ipAddressValidator{}
+}
+
+// IsIPv6AddressValid returns an AttributeValidator which ensures that any
+// configured attribute value is an IPv6 address.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func IsIPv6AddressValid() validator.String {
+	return ipAddressValidator{ipv6: true}
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnsvalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIsIPAddressValid(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		ipv6        bool
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"string unknown": {
+			val:         types.StringUnknown(),
+			expectError: false,
+		},
+		"string null": {
+			val:         types.StringNull(),
+			expectError: false,
+		},
+		"string empty": {
+			val:         types.StringValue(""),
+			expectError: true,
+		},
+		"ipv4": {
+			val:         types.StringValue("192.168.0.1"),
+			expectError: false,
+		},
+		"ipv4 with leading zeros": {
+			val:         types.StringValue("192.168.000.001"),
+			expectError: false,
+		},
+		"ipv6 as ipv4": {
+			val:         types.StringValue("fdd5:e282::1"),
+			expectError: true,
+		},
+		"ipv4-mapped ipv6 as ipv4": {
+			val:         types.StringValue("::ffff:192.168.0.1"),
+			expectError: true,
+		},
+		"ipv6": {
+			val:         types.StringValue("fdd5:e282::1"),
+			ipv6:        true,
+			expectError: false,
+		},
+		"ipv4 as ipv6": {
+			val:         types.StringValue("192.168.0.1"),
+			ipv6:        true,
+			expectError: true,
+		},
+		"not an address": {
+			val:         types.StringValue("example.com."),
+			ipv6:        true,
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+
+			response := validator.StringResponse{}
+			if test.ipv6 {
+				IsIPv6AddressValid().ValidateString(context.TODO(), request, &response)
+			} else {
+				IsIPv4AddressValid().ValidateString(context.TODO(), request, &response)
+			}
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnsvalidator
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/miekg/dns"
+)
+
+var _ validator.String = dnsRecordFQDNValidator{}
+
+// dnsRecordFQDNValidator validates if the provided value is a fully qualified
+// DNS record name and contains no whitespace.
+type dnsRecordFQDNValidator struct {
+	rules nameRules
+}
+
+func (validator dnsRecordFQDNValidator) Description(ctx context.Context) string {
+	return "value must be a fully qualified DNS record name"
+}
+
+func (validator dnsRecordFQDNValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+func (validator dnsRecordFQDNValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// Only validate the attribute configuration value if it is known.
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if strings.TrimSpace(value) != value {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(
+			req.Path,
+			"DNS record name must not contain whitespace",
+			req.ConfigValue.ValueString(),
+		))
+	}
+	validateName(req, resp, "DNS record name", validator.rules)
+	if !dns.IsFqdn(value) {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(
+			req.Path,
+			"DNS record name must be fully qualified",
+			req.ConfigValue.ValueString(),
+		))
+	}
+}
+
+// IsRecordFQDNValid returns an AttributeValidator which ensures that any
+// configured attribute value:
+//
+//   - Is a non-empty String.
+//   - Contains no whitespace.
+//   - Is a valid internationalized domain name, if it contains non-ASCII
+//     characters.
+//   - Has no label longer than 63 octets and is no longer than 253
+//     characters, not counting the trailing dot.
+//   - Contains a wildcard only as its leftmost label.
+//   - Contains only characters allowed in domain names, including
+//     underscores, as used by SRV and TLSA records. Owner names are not
+//     restricted to host name syntax, whatever the type of the records.
+//   - Is a fully qualified DNS record name.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func IsRecordFQDNValid() validator.String {
+	return dnsRecordFQDNValidator{rules: recordNameRules}
+}
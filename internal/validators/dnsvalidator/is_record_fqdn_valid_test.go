@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnsvalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIsRecordFQDNValid(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"string unknown": {
+			val:         types.StringUnknown(),
+			expectError: false,
+		},
+		"string null": {
+			val:         types.StringNull(),
+			expectError: false,
+		},
+		"string empty": {
+			val:         types.StringValue(""),
+			expectError: true,
+		},
+		"not a fully qualified DNS name": {
+			val:         types.StringValue("www.example.com"),
+			expectError: true,
+		},
+		"string contains whitespace": {
+			val:         types.StringValue(" www.example.com."),
+			expectError: true,
+		},
+		"success scenario": {
+			val:         types.StringValue("www.example.com."),
+			expectError: false,
+		},
+		"leftmost wildcard": {
+			val:         types.StringValue("*.example.com."),
+			expectError: false,
+		},
+		"wildcard not leftmost": {
+			val:         types.StringValue("www.*.example.com."),
+			expectError: true,
+		},
+		"underscore": {
+			val:         types.StringValue("_sip._tcp.example.com."),
+			expectError: false,
+		},
+		"underscore in an address record name": {
+			val:         types.StringValue("_acme.example.com."),
+			expectError: false,
+		},
+		"internationalized domain name": {
+			val:         types.StringValue("www.bücher.example."),
+			expectError: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+
+			response := validator.StringResponse{}
+			IsRecordFQDNValid().ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
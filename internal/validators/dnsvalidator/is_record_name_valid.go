@@ -16,6 +16,7 @@ var _ validator.String = dnsRecordNameValidator{}
 
 // dnsZoneValidator validates if the provided value is a fully qualified DNS zone name and contains no whitespace.
 type dnsRecordNameValidator struct {
+	rules nameRules
 }
 
 func (validator dnsRecordNameValidator) Description(ctx context.Context) string {
@@ -41,6 +42,7 @@ func (validator dnsRecordNameValidator) ValidateString(ctx context.Context, req
 			req.ConfigValue.ValueString(),
 		))
 	}
+	validateName(req, resp, "DNS record name", validator.rules)
 	if dns.IsFqdn(value) {
 		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(
 			req.Path,
@@ -55,9 +57,17 @@ func (validator dnsRecordNameValidator) ValidateString(ctx context.Context, req
 //
 //   - Is a non-empty String.
 //   - Contains no whitespace.
+//   - Is a valid internationalized domain name, if it contains non-ASCII
+//     characters.
+//   - Has no label longer than 63 octets and is no longer than 253
+//     characters.
+//   - Contains a wildcard only as its leftmost label.
+//   - Contains only characters allowed in domain names, including
+//     underscores, as used by SRV and TLSA records. Owner names are not
+//     restricted to host name syntax, whatever the type of the records.
 //   - Is NOT a fully qualified DNS zone name.
 //
 // Null (unconfigured) and unknown (known after apply) values are skipped.
 func IsRecordNameValid() validator.String {
-	return dnsRecordNameValidator{}
+	return dnsRecordNameValidator{rules: recordNameRules}
 }
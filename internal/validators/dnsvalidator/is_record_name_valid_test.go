@@ -5,6 +5,7 @@ package dnsvalidator
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -49,6 +50,74 @@ func TestIsRecordNameValid(t *testing.T) {
 			val:         types.StringValue("test"),
 			expectError: false,
 		},
+		"internationalized domain name": {
+			val:         types.StringValue("bücher"),
+			expectError: false,
+		},
+		"invalid internationalized domain name": {
+			val:         types.StringValue("aש"),
+			expectError: true,
+		},
+		"label of 63 octets": {
+			val:         types.StringValue(strings.Repeat("a", 63)),
+			expectError: false,
+		},
+		"label longer than 63 octets": {
+			val:         types.StringValue(strings.Repeat("a", 64)),
+			expectError: true,
+		},
+		"escaped label of 63 octets": {
+			val:         types.StringValue(`\0460` + strings.Repeat("a", 61)),
+			expectError: false,
+		},
+		"name of 253 characters": {
+			val:         types.StringValue(strings.Repeat(strings.Repeat("a", 62)+".", 4) + "a"),
+			expectError: false,
+		},
+		"name longer than 253 characters": {
+			val:         types.StringValue(strings.Repeat(strings.Repeat("a", 62)+".", 4) + "aa"),
+			expectError: true,
+		},
+		"empty label": {
+			val:         types.StringValue("foo..bar"),
+			expectError: true,
+		},
+		"wildcard": {
+			val:         types.StringValue("*"),
+			expectError: false,
+		},
+		"leftmost wildcard": {
+			val:         types.StringValue("*.foo"),
+			expectError: false,
+		},
+		"wildcard not leftmost": {
+			val:         types.StringValue("foo.*"),
+			expectError: true,
+		},
+		"asterisk within label": {
+			val:         types.StringValue("*foo"),
+			expectError: false,
+		},
+		"underscore for SRV": {
+			val:         types.StringValue("_sip._tcp"),
+			expectError: false,
+		},
+		"underscore for TLSA": {
+			val:         types.StringValue("_443._tcp.www"),
+			expectError: false,
+		},
+		"underscore in an address record name": {
+			val:         types.StringValue("_acme"),
+			expectError: false,
+		},
+		"leading hyphen": {
+			val:         types.StringValue("-foo"),
+			expectError: false,
+		},
+		"host name": {
+			val:         types.StringValue("*.www-1"),
+			expectError: false,
+		},
 	}
 
 	for name, test := range tests {
@@ -40,6 +40,7 @@ func (validator dnsZoneNameValidator) ValidateString(ctx context.Context, req va
 			req.ConfigValue.ValueString(),
 		))
 	}
+	validateName(req, resp, "DNS zone name", nameRules{})
 	if !dns.IsFqdn(value) {
 		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(
 			req.Path,
@@ -54,6 +55,11 @@ func (validator dnsZoneNameValidator) ValidateString(ctx context.Context, req va
 //
 //   - Is a non-empty String.
 //   - Contains no whitespace.
+//   - Is a valid internationalized domain name, if it contains non-ASCII
+//     characters.
+//   - Has no label longer than 63 octets and is no longer than 253
+//     characters, not counting the trailing dot.
+//   - Contains no wildcard label.
 //   - Is a fully qualified DNS zone name.
 //
 // Null (unconfigured) and unknown (known after apply) values are skipped.
@@ -5,6 +5,7 @@ package dnsvalidator
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -49,6 +50,34 @@ func TestIsZoneNameValid(t *testing.T) {
 			val:         types.StringValue("example.com."),
 			expectError: false,
 		},
+		"internationalized domain name": {
+			val:         types.StringValue("bücher.example."),
+			expectError: false,
+		},
+		"invalid internationalized domain name": {
+			val:         types.StringValue("aש.example."),
+			expectError: true,
+		},
+		"label longer than 63 octets": {
+			val:         types.StringValue(strings.Repeat("a", 64) + ".example.com."),
+			expectError: true,
+		},
+		"name of 253 characters": {
+			val:         types.StringValue(strings.Repeat(strings.Repeat("a", 62)+".", 4) + "a."),
+			expectError: false,
+		},
+		"name longer than 253 characters": {
+			val:         types.StringValue(strings.Repeat(strings.Repeat("a", 62)+".", 4) + "aa."),
+			expectError: true,
+		},
+		"wildcard": {
+			val:         types.StringValue("*.example.com."),
+			expectError: true,
+		},
+		"underscore": {
+			val:         types.StringValue("_domainkey.example.com."),
+			expectError: false,
+		},
 	}
 
 	for name, test := range tests {
@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnsvalidator
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/terraform-provider-dns/internal/idn"
+)
+
+const (
+	// maxLabelLength is the maximum length of a label in octets.
+	maxLabelLength = 63
+	// maxNameLength is the maximum length of a name in presentation format
+	// without the trailing dot, which is 255 octets in wire format.
+	maxNameLength = 253
+)
+
+// nameRules are the syntax rules checked by checkName on top of those
+// enforced by dns.IsDomainName.
+type nameRules struct {
+	// wildcard allows "*" as the leftmost label.
+	wildcard bool
+	// hostname restricts labels to letters, digits and inner hyphens as
+	// required for host names by RFC 952 and RFC 1123. Without it, labels
+	// may also contain underscores, as used by SRV and TLSA records, or any
+	// other character allowed in domain names.
+	hostname bool
+}
+
+// recordNameRules are the rules for the owner names of records. Host name
+// syntax only applies to the targets of records, such as MX exchanges, as
+// owner names of any type may contain underscores, such as "_acme".
+var recordNameRules = nameRules{wildcard: true}
+
+// validateName checks that the configured value is a valid, possibly
+// internationalized, domain name following rules. subject names the kind of
+// name in diagnostics.
+func validateName(req validator.StringRequest, resp *validator.StringResponse, subject string, rules nameRules) {
+	value := req.ConfigValue.ValueString()
+
+	ascii, err := idn.ToASCII(value)
+	if err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(
+			req.Path,
+			fmt.Sprintf("%s must be a valid internationalized domain name: %s", subject, err),
+			value,
+		))
+		return
+	}
+
+	for _, problem := range checkName(ascii, rules) {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(
+			req.Path,
+			subject+" "+problem,
+			value,
+		))
+	}
+}
+
+// checkName returns a description of each rule the ASCII form of a domain name
+// violates.
+func checkName(name string, rules nameRules) []string {
+	var problems []string
+
+	if name == "" || name == "." {
+		return problems
+	}
+
+	labels := dns.SplitDomainName(name)
+	length := len(name)
+	if dns.IsFqdn(name) {
+		length--
+	}
+
+	if length > maxNameLength {
+		problems = append(problems, fmt.Sprintf("must not be longer than %d characters", maxNameLength))
+	}
+
+	for i, label := range labels {
+		if labelLength(label) > maxLabelLength {
+			problems = append(problems, fmt.Sprintf("must not contain labels longer than %d octets: %q", maxLabelLength, label))
+		}
+
+		if label == "*" {
+			if i != 0 || !rules.wildcard {
+				problems = append(problems, "must only contain a wildcard as its leftmost label")
+			}
+			continue
+		}
+
+		if rules.hostname && !isHostnameLabel(label) {
+			problems = append(problems, fmt.Sprintf("must only contain letters, digits and hyphens not at the start or end of a label: %q", label))
+		}
+	}
+
+	if _, ok := dns.IsDomainName(name); !ok && len(problems) == 0 {
+		problems = append(problems, "must be a valid domain name")
+	}
+
+	return problems
+}
+
+// labelLength returns the length of a label in presentation format in octets,
+// counting escape sequences as the octet they represent.
+func labelLength(label string) int {
+	length := 0
+
+	for i := 0; i < len(label); i++ {
+		if label[i] == '\\' && i+1 < len(label) {
+			if i+3 < len(label) && isDigit(label[i+1]) && isDigit(label[i+2]) && isDigit(label[i+3]) {
+				i += 3
+			} else {
+				i++
+			}
+		}
+		length++
+	}
+
+	return length
+}
+
+func isHostnameLabel(label string) bool {
+	if label == "" || label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+
+	for i := 0; i < len(label); i++ {
+		c := label[i]
+		if !isDigit(c) && c != '-' && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
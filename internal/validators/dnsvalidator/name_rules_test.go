@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2017, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnsvalidator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name     string
+		rules    nameRules
+		problems int
+	}{
+		"valid": {
+			name: "www.example.com.",
+		},
+		"root": {
+			name:  ".",
+			rules: nameRules{hostname: true},
+		},
+		"label too long": {
+			name:     strings.Repeat("a", 64) + ".example.com.",
+			problems: 1,
+		},
+		"labels too long": {
+			name:     strings.Repeat("a", 64) + "." + strings.Repeat("b", 64) + ".",
+			problems: 2,
+		},
+		"name too long": {
+			name:     strings.Repeat(strings.Repeat("a", 62)+".", 4) + "aa.",
+			problems: 1,
+		},
+		"wildcard allowed": {
+			name:  "*.example.com.",
+			rules: nameRules{wildcard: true},
+		},
+		"wildcard not allowed": {
+			name:     "*.example.com.",
+			problems: 1,
+		},
+		"wildcard not leftmost": {
+			name:     "www.*.example.com.",
+			rules:    nameRules{wildcard: true},
+			problems: 1,
+		},
+		"underscore": {
+			name: "_dmarc.example.com.",
+		},
+		"underscore in host name": {
+			name:     "_dmarc.example.com.",
+			rules:    nameRules{hostname: true},
+			problems: 1,
+		},
+		"slash": {
+			name: "0/26.2.0.192.in-addr.arpa.",
+		},
+		"slash in host name": {
+			name:     "0/26.2.0.192.in-addr.arpa.",
+			rules:    nameRules{hostname: true},
+			problems: 1,
+		},
+		"leading hyphen in host name": {
+			name:     "-www.example.com.",
+			rules:    nameRules{hostname: true},
+			problems: 1,
+		},
+		"empty label": {
+			name:     "www..example.com.",
+			problems: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			problems := checkName(testCase.name, testCase.rules)
+
+			if len(problems) != testCase.problems {
+				t.Errorf("expected %d problems, got %q", testCase.problems, problems)
+			}
+		})
+	}
+}
+
+func TestLabelLength(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		label    string
+		expected int
+	}{
+		"plain":           {label: "www", expected: 3},
+		"escaped dot":     {label: `a\.b`, expected: 3},
+		"decimal escape":  {label: `a\032b`, expected: 3},
+		"escaped escape":  {label: `a\\b`, expected: 3},
+		"trailing escape": {label: `ab\`, expected: 3},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := labelLength(testCase.label); got != testCase.expected {
+				t.Errorf("expected %d, got %d", testCase.expected, got)
+			}
+		})
+	}
+}
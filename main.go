@@ -7,10 +7,9 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
-	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
-	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
@@ -18,32 +17,27 @@ import (
 )
 
 func main() {
-	ctx := context.Background()
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		if err := provider.Generate(context.Background(), os.Args[2:], os.Stdout, os.Stderr); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	providers := []func() tfprotov5.ProviderServer{
-		providerserver.NewProtocol5(provider.NewFrameworkProvider()),
-		provider.New().GRPCProvider,
-	}
-
-	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
-	if err != nil {
-		log.Fatal(err)
-	}
-
 	var serveOpts []tf5server.ServeOpt
 
 	if debug {
 		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
 	}
 
-	err = tf5server.Serve(
+	err := tf5server.Serve(
 		"registry.terraform.io/hashicorp/dns",
-		muxServer.ProviderServer,
+		providerserver.NewProtocol5(provider.NewFrameworkProvider()),
 		serveOpts...,
 	)
 	if err != nil {